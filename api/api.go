@@ -1,11 +1,13 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
 	"github.com/yusefnapora/party-line/audio"
+	"github.com/yusefnapora/party-line/messages"
 	"github.com/yusefnapora/party-line/types"
 	"io/ioutil"
 	"net/http"
@@ -16,12 +18,17 @@ import (
 	"time"
 )
 
+// the largest audio file we'll accept from the UI
+const maxUploadSize = 10 << 20
+
 type Handler struct {
 	pathPrefix string
 	localUser  *types.UserInfo
 
 	audioRecorder *audio.Recorder
 	audioStore    *audio.Store
+	audioPlayer   *audio.Player
+	messageStore  *messages.Store
 
 	eventCh      <-chan *types.Event
 	evtListeners map[string]chan *types.Event
@@ -30,13 +37,15 @@ type Handler struct {
 	dispatcher *Dispatcher
 }
 
-func NewHandler(pathPrefix string, localUser *types.UserInfo, recorder *audio.Recorder, store *audio.Store, dispatcher *Dispatcher) (*Handler, error) {
+func NewHandler(pathPrefix string, localUser *types.UserInfo, recorder *audio.Recorder, store *audio.Store, player *audio.Player, messageStore *messages.Store, dispatcher *Dispatcher) (*Handler, error) {
 
 	h := &Handler{
 		pathPrefix:    pathPrefix,
 		localUser:     localUser,
 		audioRecorder: recorder,
 		audioStore:    store,
+		audioPlayer:   player,
+		messageStore:  messageStore,
 		dispatcher:    dispatcher,
 		evtListeners:  make(map[string]chan *types.Event),
 	}
@@ -83,15 +92,60 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	case "/audio-inputs":
 		h.ListAudioInputs(w, r)
 
+	case "/select-audio-input":
+		h.SelectAudioInput(w, r)
+
+	case "/audio-outputs":
+		h.ListAudioOutputs(w, r)
+
+	case "/select-audio-output":
+		h.SelectAudioOutput(w, r)
+
+	case "/playback-settings":
+		h.ServePlaybackSettings(w, r)
+
+	case "/set-master-gain":
+		h.SetMasterGain(w, r)
+
+	case "/live-stats":
+		h.ServeLiveStats(w, r)
+
+	case "/set-peer-gain":
+		h.SetPeerGain(w, r)
+
+	case "/set-muted":
+		h.SetMuted(w, r)
+
 	case "/begin-recording":
 		h.StartRecording(w, r)
 
+	case "/begin-talk":
+		h.BeginTalk(w, r)
+
 	case "/end-recording":
 		h.EndRecording(w, r)
 
 	case "/play-recording":
 		h.PlayRecording(w, r)
 
+	case "/pause-playback":
+		h.PausePlayback(w, r)
+
+	case "/resume-playback":
+		h.ResumePlayback(w, r)
+
+	case "/stop-playback":
+		h.StopPlayback(w, r)
+
+	case "/seek-playback":
+		h.SeekPlayback(w, r)
+
+	case "/download-recording":
+		h.DownloadRecording(w, r)
+
+	case "/upload-recording":
+		h.UploadRecording(w, r)
+
 	case "/peers":
 		h.ListPeers(w, r)
 
@@ -106,11 +160,28 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	case "/connect-to-peer":
 		h.ConnectToPeer(w, r)
+
+	case "/join-room":
+		h.JoinRoom(w, r)
+
+	case "/mark-message-read":
+		h.MarkMessageRead(w, r)
+
+	case "/message-history":
+		h.MessageHistory(w, r)
 	}
 }
 
 func (h *Handler) ListAudioInputs(w http.ResponseWriter, r *http.Request) {
 	devices := audio.ListInputDevices()
+	selected := h.audioRecorder.InputDeviceID()
+	for _, d := range devices {
+		if selected == "" {
+			d.IsSelected = d.IsDefault
+		} else {
+			d.IsSelected = d.DeviceId == selected
+		}
+	}
 	resp := &types.InputDeviceList{Devices: devices}
 	buf, err := proto.Marshal(resp)
 	if err != nil {
@@ -122,6 +193,177 @@ func (h *Handler) ListAudioInputs(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *Handler) SelectAudioInput(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("unmarshal error: %s", err), 400)
+		return
+	}
+	req := &types.SelectInputDeviceRequest{}
+	if err := proto.Unmarshal(buf, req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+
+	if err := h.audioRecorder.SelectInputDevice(req.DeviceId); err != nil {
+		writeErrorResponse(w, err.Error(), 500)
+		return
+	}
+	writeEmptyOk(w)
+}
+
+func (h *Handler) ListAudioOutputs(w http.ResponseWriter, r *http.Request) {
+	devices, err := audio.ListOutputDevices()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error listing output devices: %s", err), 500)
+		return
+	}
+	selected := h.audioStore.OutputDeviceID()
+	for _, d := range devices {
+		if selected == "" {
+			d.IsSelected = d.IsDefault
+		} else {
+			d.IsSelected = d.DeviceId == selected
+		}
+	}
+	resp := &types.OutputDeviceList{Devices: devices}
+	buf, err := proto.Marshal(resp)
+	if err != nil {
+		http.Error(w, "Error encoding response", 500)
+		return
+	}
+	if _, err = w.Write(buf); err != nil {
+		fmt.Printf("io error: %s\n", err)
+	}
+}
+
+func (h *Handler) SelectAudioOutput(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("unmarshal error: %s", err), 400)
+		return
+	}
+	req := &types.SelectOutputDeviceRequest{}
+	if err := proto.Unmarshal(buf, req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+
+	if err := h.audioStore.SelectOutputDevice(req.DeviceId); err != nil {
+		writeErrorResponse(w, err.Error(), 500)
+		return
+	}
+	writeEmptyOk(w)
+}
+
+func (h *Handler) ServePlaybackSettings(w http.ResponseWriter, r *http.Request) {
+	gain, muted := h.audioStore.PlaybackSettings()
+	resp := &types.PlaybackSettings{MasterGain: gain, Muted: muted}
+	buf, err := proto.Marshal(resp)
+	if err != nil {
+		http.Error(w, "Error encoding response", 500)
+		return
+	}
+	if _, err = w.Write(buf); err != nil {
+		fmt.Printf("io error: %s\n", err)
+	}
+}
+
+func (h *Handler) SetMasterGain(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("unmarshal error: %s", err), 400)
+		return
+	}
+	req := &types.SetMasterGainRequest{}
+	if err := proto.Unmarshal(buf, req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+
+	if err := h.audioStore.SetMasterGain(req.Gain); err != nil {
+		writeErrorResponse(w, err.Error(), 400)
+		return
+	}
+	writeEmptyOk(w)
+}
+
+// ServeLiveStats reports how well live audio from peers is arriving: jitter, late and lost frames,
+// and how many lost frames were recovered.
+func (h *Handler) ServeLiveStats(w http.ResponseWriter, r *http.Request) {
+	resp := &types.LiveStreamStatsList{Streams: h.audioStore.LiveStreamStats()}
+	buf, err := proto.Marshal(resp)
+	if err != nil {
+		http.Error(w, "Error encoding response", 500)
+		return
+	}
+	if _, err = w.Write(buf); err != nil {
+		fmt.Printf("io error: %s\n", err)
+	}
+}
+
+func (h *Handler) SetPeerGain(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("unmarshal error: %s", err), 400)
+		return
+	}
+	req := &types.SetPeerGainRequest{}
+	if err := proto.Unmarshal(buf, req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+	if req.PeerId == "" {
+		writeErrorResponse(w, "peer id is required", 400)
+		return
+	}
+
+	if err := h.audioStore.SetPeerGain(req.PeerId, req.Gain); err != nil {
+		writeErrorResponse(w, err.Error(), 400)
+		return
+	}
+	writeEmptyOk(w)
+}
+
+func (h *Handler) SetMuted(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("unmarshal error: %s", err), 400)
+		return
+	}
+	req := &types.SetMutedRequest{}
+	if err := proto.Unmarshal(buf, req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+
+	if err := h.audioStore.SetMuted(req.Muted); err != nil {
+		writeErrorResponse(w, err.Error(), 500)
+		return
+	}
+	writeEmptyOk(w)
+}
+
 func (h *Handler) ListPeers(w http.ResponseWriter, r *http.Request) {
 	http.Error(w, "coming soon", 501)
 }
@@ -146,6 +388,87 @@ func (h *Handler) ConnectToPeer(w http.ResponseWriter, r *http.Request) {
 	writeEmptyOk(w)
 }
 
+func (h *Handler) JoinRoom(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("unmarshal error: %s", err), 400)
+		return
+	}
+	req := &types.JoinRoomRequest{}
+	if err := proto.Unmarshal(buf, req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+	if strings.TrimSpace(req.RoomName) == "" {
+		writeErrorResponse(w, "room name must not be empty", 400)
+		return
+	}
+
+	h.dispatcher.JoinRoomRequested(req)
+	writeEmptyOk(w)
+}
+
+func (h *Handler) MarkMessageRead(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("unmarshal error: %s", err), 400)
+		return
+	}
+	req := &types.MarkMessageReadRequest{}
+	if err := proto.Unmarshal(buf, req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+	if req.MessageId == "" || req.Author == nil {
+		writeErrorResponse(w, "message id and author are required", 400)
+		return
+	}
+
+	h.dispatcher.MessageReadRequested(req)
+	writeEmptyOk(w)
+}
+
+func (h *Handler) MessageHistory(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("unmarshal error: %s", err), 400)
+		return
+	}
+	req := &types.MessageHistoryRequest{}
+	if err := proto.Unmarshal(buf, req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+
+	page, err := h.messageStore.Page(req.BeforeAuthorPeerId, req.BeforeMessageId, int(req.Limit))
+	if err != nil {
+		writeErrorResponse(w, err.Error(), 404)
+		return
+	}
+
+	resp := &types.ApiResponse{Resp: &types.ApiResponse_MessageHistory{MessageHistory: page}}
+	buf, err = proto.Marshal(resp)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("marshal error: %s", err), 500)
+		return
+	}
+	if _, err = w.Write(buf); err != nil {
+		fmt.Printf("io error: %s\n", err)
+	}
+}
+
 func (h *Handler) PublishMessage(w http.ResponseWriter, r *http.Request) {
 	if ensureMethod("POST", w, r) {
 		return
@@ -216,7 +539,16 @@ func (h *Handler) StartRecording(w http.ResponseWriter, r *http.Request) {
 
 	fmt.Printf("request: %v\n", req)
 
-	recordingId, err := h.audioRecorder.BeginRecording(time.Duration(0))
+	var maxDuration time.Duration
+	if req.MaxDuration != "" {
+		maxDuration, err = time.ParseDuration(req.MaxDuration)
+		if err != nil {
+			writeErrorResponse(w, fmt.Sprintf("invalid max duration: %s", err), 400)
+			return
+		}
+	}
+
+	recordingId, err := h.audioRecorder.BeginRecording(maxDuration, h.dispatcher.RecordingStopped)
 	if err != nil {
 		writeErrorResponse(w, err.Error(), 500)
 		return
@@ -236,6 +568,49 @@ func (h *Handler) StartRecording(w http.ResponseWriter, r *http.Request) {
 	w.Write(buf)
 }
 
+// BeginTalk starts streaming the mic live to connected peers. It's stopped with /end-recording, like a recording.
+func (h *Handler) BeginTalk(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("post", w, r) {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("io error: %s", err), 400)
+		return
+	}
+	req := types.BeginTalkRequest{}
+	if err := proto.Unmarshal(body, &req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+
+	var maxDuration time.Duration
+	if req.MaxDuration != "" {
+		maxDuration, err = time.ParseDuration(req.MaxDuration)
+		if err != nil {
+			writeErrorResponse(w, fmt.Sprintf("invalid max duration: %s", err), 400)
+			return
+		}
+	}
+
+	talkID, err := h.audioRecorder.BeginTalking(maxDuration, h.dispatcher.RecordingStopped, req.SaveRecording)
+	if err != nil {
+		writeErrorResponse(w, err.Error(), 500)
+		return
+	}
+
+	resp := &types.ApiResponse{Resp: &types.ApiResponse_BeginAudioRecording{BeginAudioRecording: &types.BeginAudioRecordingResponse{
+		RecordingId: talkID,
+	}}}
+	buf, err := proto.Marshal(resp)
+	if err != nil {
+		http.Error(w, "Error encoding response", 500)
+		return
+	}
+	w.Write(buf)
+}
+
 func (h *Handler) EndRecording(w http.ResponseWriter, r *http.Request) {
 	if ensureMethod("post", w, r) {
 		return
@@ -267,15 +642,153 @@ func (h *Handler) PlayRecording(w http.ResponseWriter, r *http.Request) {
 	body, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		writeErrorResponse(w, fmt.Sprintf("io error: %s", err), 400)
+		return
 	}
 	req := types.PlayAudioRecordingRequest{}
 	if err := proto.Unmarshal(body, &req); err != nil {
 		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+
+	gain := req.Gain
+	if gain == 0 {
+		gain = 1
+	} else if gain < 0 {
+		writeErrorResponse(w, fmt.Sprintf("invalid gain %v", gain), 400)
+		return
 	}
 
-	err = h.audioStore.PlayRecording(req.RecordingId)
+	// this only queues the recording; the progress comes back as playback events
+	err = h.audioPlayer.Play(req.RecordingId, gain, req.Interrupt)
 	if err != nil {
-		writeErrorResponse(w, err.Error(), 500)
+		writeErrorResponse(w, err.Error(), 404)
+		return
+	}
+	writeEmptyOk(w)
+}
+
+func (h *Handler) PausePlayback(w http.ResponseWriter, r *http.Request) {
+	h.controlPlayback(w, r, h.audioPlayer.Pause)
+}
+
+func (h *Handler) ResumePlayback(w http.ResponseWriter, r *http.Request) {
+	h.controlPlayback(w, r, h.audioPlayer.Resume)
+}
+
+func (h *Handler) StopPlayback(w http.ResponseWriter, r *http.Request) {
+	h.controlPlayback(w, r, h.audioPlayer.Stop)
+}
+
+// controlPlayback decodes a PlaybackControlRequest and applies fn to its recording id.
+func (h *Handler) controlPlayback(w http.ResponseWriter, r *http.Request, fn func(recordingID string) error) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("unmarshal error: %s", err), 400)
+		return
+	}
+	req := &types.PlaybackControlRequest{}
+	if err := proto.Unmarshal(buf, req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+
+	if err := fn(req.RecordingId); err != nil {
+		writeErrorResponse(w, err.Error(), 409)
+		return
+	}
+	writeEmptyOk(w)
+}
+
+func (h *Handler) SeekPlayback(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("unmarshal error: %s", err), 400)
+		return
+	}
+	req := &types.SeekPlaybackRequest{}
+	if err := proto.Unmarshal(buf, req); err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error decoding request: %s", err), 400)
+		return
+	}
+
+	if err := h.audioPlayer.Seek(req.RecordingId, int(req.FrameIndex)); err != nil {
+		writeErrorResponse(w, err.Error(), 409)
+		return
+	}
+	writeEmptyOk(w)
+}
+
+// DownloadRecording serves the recording with the id given in the "id" query param as an Ogg Opus file,
+// or as a decoded WAV file if the "format" query param is "wav".
+func (h *Handler) DownloadRecording(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("GET", w, r) {
+		return
+	}
+
+	id := r.URL.Query().Get("id")
+	rec, ok := h.audioStore.GetRecording(id)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no recording found with id %s", id), 404)
+		return
+	}
+
+	var buf bytes.Buffer
+	var contentType, filename string
+	var err error
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "opus":
+		contentType, filename = "audio/ogg", id+".opus"
+		err = rec.WriteOgg(&buf)
+	case "wav":
+		contentType, filename = "audio/wav", id+".wav"
+		err = rec.WriteWAV(&buf)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported format %s", format), 400)
+		return
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error encoding recording: %s", err), 500)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		fmt.Printf("io error: %s\n", err)
+	}
+}
+
+// UploadRecording imports an Ogg Opus file from the request body as a new recording.
+func (h *Handler) UploadRecording(w http.ResponseWriter, r *http.Request) {
+	if ensureMethod("POST", w, r) {
+		return
+	}
+
+	rec, err := h.audioStore.ImportOgg(http.MaxBytesReader(w, r.Body, maxUploadSize))
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("error importing recording: %s", err), 400)
+		return
+	}
+
+	resp := &types.ApiResponse{Resp: &types.ApiResponse_ImportAudio{ImportAudio: &types.ImportAudioResponse{
+		RecordingId: rec.ID,
+		DurationMs:  rec.DurationMs(),
+	}}}
+	buf, err := proto.Marshal(resp)
+	if err != nil {
+		writeErrorResponse(w, fmt.Sprintf("marshal error: %s", err), 500)
+		return
+	}
+	if _, err = w.Write(buf); err != nil {
+		fmt.Printf("io error: %s\n", err)
 	}
 }
 
@@ -1,13 +1,14 @@
 package api
 
 import (
+	"github.com/google/uuid"
 	"github.com/yusefnapora/party-line/types"
 	"sync"
 	"time"
 )
 
 type Dispatcher struct {
-	incoming chan *types.Message
+	incoming chan *types.MessageReceivedEvent
 	outgoing chan *types.Message
 
 	publishCh chan<- *types.Message
@@ -19,7 +20,7 @@ type Dispatcher struct {
 
 func NewDispatcher(publishCh chan<- *types.Message) *Dispatcher {
 	d := &Dispatcher{
-		incoming:  make(chan *types.Message, 1024),
+		incoming:  make(chan *types.MessageReceivedEvent, 1024),
 		outgoing:  make(chan *types.Message, 1024),
 		publishCh: publishCh,
 		stop:      make(chan struct{}),
@@ -39,6 +40,34 @@ func (d *Dispatcher) PeerJoined(user *types.UserInfo) {
 	d.pushToListeners(evt)
 }
 
+func (d *Dispatcher) PeerLeft(user *types.UserInfo) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_UserLeft{UserLeft: &types.UserLeftEvent{User: user}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) ConnectionStateChanged(user *types.UserInfo, state types.ConnectionState) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_ConnectionStateChanged{ConnectionStateChanged: &types.ConnectionStateChangedEvent{User: user, State: state}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) AuthenticationFailed(senderPeerId string, claimedUser *types.UserInfo, reason string) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt: &types.Event_AuthenticationFailed{AuthenticationFailed: &types.AuthenticationFailedEvent{
+			SenderPeerId: senderPeerId,
+			ClaimedUser:  claimedUser,
+			Reason:       reason,
+		}},
+	}
+	d.pushToListeners(evt)
+}
+
 func (d *Dispatcher) ConnectToPeerRequested(req *types.ConnectToPeerRequest) {
 	evt := &types.Event{
 		TimestampUnix: time.Now().Unix(),
@@ -47,12 +76,147 @@ func (d *Dispatcher) ConnectToPeerRequested(req *types.ConnectToPeerRequest) {
 	d.pushToListeners(evt)
 }
 
+func (d *Dispatcher) JoinRoomRequested(req *types.JoinRoomRequest) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_JoinRoomRequested{JoinRoomRequested: &types.JoinRoomRequestedEvent{Request: req}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) MessageReadRequested(req *types.MarkMessageReadRequest) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_MessageReadRequested{MessageReadRequested: &types.MessageReadRequestedEvent{Request: req}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) ReceiptReceived(receipt *types.Receipt) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_ReceiptReceived{ReceiptReceived: &types.ReceiptReceivedEvent{Receipt: receipt}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) OutboxStatusChanged(messageID string, recipient *types.UserInfo, status types.OutboxStatus) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt: &types.Event_OutboxStatusChanged{OutboxStatusChanged: &types.OutboxStatusChangedEvent{
+			MessageId: messageID,
+			Recipient: recipient,
+			Status:    status,
+		}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) RecordingStopped(recordingID string) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_RecordingStopped{RecordingStopped: &types.RecordingStoppedEvent{RecordingId: recordingID}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) PlaybackStarted(recordingID string, totalFrames int, durationMs int64) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt: &types.Event_PlaybackStarted{PlaybackStarted: &types.PlaybackStartedEvent{
+			RecordingId: recordingID,
+			TotalFrames: uint32(totalFrames),
+			DurationMs:  durationMs,
+		}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) PlaybackProgress(recordingID string, frameIndex int, totalFrames int, paused bool) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt: &types.Event_PlaybackProgress{PlaybackProgress: &types.PlaybackProgressEvent{
+			RecordingId: recordingID,
+			FrameIndex:  uint32(frameIndex),
+			TotalFrames: uint32(totalFrames),
+			Paused:      paused,
+		}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) PlaybackFinished(recordingID string, stopped bool) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_PlaybackFinished{PlaybackFinished: &types.PlaybackFinishedEvent{RecordingId: recordingID, Stopped: stopped}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) LiveVoiceStarted(talkID string, talker *types.UserInfo) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_LiveVoiceStarted{LiveVoiceStarted: &types.LiveVoiceStartedEvent{TalkId: talkID, Talker: talker}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) LiveVoiceEnded(talkID string, talker *types.UserInfo) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_LiveVoiceEnded{LiveVoiceEnded: &types.LiveVoiceEndedEvent{TalkId: talkID, Talker: talker}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) RoomMemberFound(roomName string, user *types.UserInfo) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_RoomMemberFound{RoomMemberFound: &types.RoomMemberFoundEvent{RoomName: roomName, User: user}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) RelayAddressAcquired(relayAddr string) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_RelayAddressAcquired{RelayAddressAcquired: &types.RelayAddressAcquiredEvent{RelayAddr: relayAddr}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) NATTypeDetected(transport string, deviceType string, holePunchingSupported bool) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt: &types.Event_NatTypeDetected{NatTypeDetected: &types.NATTypeDetectedEvent{
+			TransportProtocol:     transport,
+			NatDeviceType:         deviceType,
+			HolePunchingSupported: holePunchingSupported,
+		}},
+	}
+	d.pushToListeners(evt)
+}
+
+func (d *Dispatcher) PeerDiscovered(user *types.UserInfo, addrs []string) {
+	evt := &types.Event{
+		TimestampUnix: time.Now().Unix(),
+		Evt:           &types.Event_PeerDiscovered{PeerDiscovered: &types.PeerDiscoveredEvent{User: user, Addrs: addrs}},
+	}
+	d.pushToListeners(evt)
+}
+
+// SendMessage publishes a message from the local user, assigning it a message id if it doesn't have one.
 func (d *Dispatcher) SendMessage(msg *types.Message) {
+	if msg.MessageId == "" {
+		msg.MessageId = uuid.New().String()
+	}
 	d.outgoing <- msg
 }
 
-func (d *Dispatcher) ReceiveMessage(msg *types.Message) {
-	d.incoming <- msg
+// ReceiveMessage is called for messages from other peers. verified should be true if the message
+// has a valid signature from its author.
+func (d *Dispatcher) ReceiveMessage(msg *types.Message, verified bool) {
+	d.incoming <- &types.MessageReceivedEvent{Message: msg, Verified: verified, MessageId: msg.MessageId}
 }
 
 func (d *Dispatcher) Stop() {
@@ -80,8 +244,8 @@ func (d *Dispatcher) loop() {
 		case <-d.stop:
 			return
 
-		case msg := <-d.incoming:
-			d.handleIncoming(msg)
+		case received := <-d.incoming:
+			d.handleIncoming(received)
 
 		case msg := <-d.outgoing:
 			d.handleOutgoing(msg)
@@ -89,10 +253,10 @@ func (d *Dispatcher) loop() {
 	}
 }
 
-func (d *Dispatcher) handleIncoming(msg *types.Message) {
+func (d *Dispatcher) handleIncoming(received *types.MessageReceivedEvent) {
 	evt := &types.Event{
 		TimestampUnix: time.Now().Unix(),
-		Evt:           &types.Event_MessageReceived{MessageReceived: &types.MessageReceivedEvent{Message: msg}},
+		Evt:           &types.Event_MessageReceived{MessageReceived: received},
 	}
 	d.pushToListeners(evt)
 }
@@ -105,7 +269,7 @@ func (d *Dispatcher) handleOutgoing(msg *types.Message) {
 	// so we can display our own messages
 	evt := &types.Event{
 		TimestampUnix: time.Now().Unix(),
-		Evt:           &types.Event_MessageSent{MessageSent: &types.MessageSentEvent{Message: msg}},
+		Evt:           &types.Event_MessageSent{MessageSent: &types.MessageSentEvent{Message: msg, MessageId: msg.MessageId}},
 	}
 	d.pushToListeners(evt)
 }
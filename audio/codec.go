@@ -0,0 +1,131 @@
+package audio
+
+import (
+	"fmt"
+
+	"gopkg.in/hraban/opus.v2"
+)
+
+// CodecProfile controls how the mic is captured and encoded. Playback doesn't depend on it, since
+// opus frames carry enough information to be decoded whatever settings they were encoded with.
+type CodecProfile struct {
+	// the rate the mic is captured and encoded at: 8000, 12000, 16000, 24000 or 48000
+	SampleRate int `json:"sample_rate"`
+
+	// 1 for mono, 2 for stereo
+	Channels int `json:"channels"`
+
+	// the length of each opus frame: 10, 20, 40 or 60 ms. Longer frames use less bandwidth,
+	// but add latency to push-to-talk.
+	FrameSizeMs int `json:"frame_size_ms"`
+
+	// the target bitrate in bits per second. Zero lets the encoder decide.
+	Bitrate int `json:"bitrate"`
+
+	// how much CPU the encoder may use, from 0 to 10. Higher is better quality.
+	Complexity int `json:"complexity"`
+
+	// InBandFEC adds enough of each frame to the next one to rebuild it if it's lost, at the cost of some
+	// bitrate. PacketLossPerc is the loss the encoder should expect, which it needs to make use of FEC.
+	InBandFEC      bool `json:"fec"`
+	PacketLossPerc int  `json:"packet_loss_perc"`
+
+	// DTX sends (almost) nothing during silence.
+	DTX bool `json:"dtx"`
+}
+
+// DefaultCodecProfile is tuned for speech: 48 kHz mono in 20ms frames, with in-band FEC so live audio
+// can recover from the occasional lost frame.
+func DefaultCodecProfile() CodecProfile {
+	return CodecProfile{
+		SampleRate:     48000,
+		Channels:       1,
+		FrameSizeMs:    20,
+		Complexity:     10,
+		InBandFEC:      true,
+		PacketLossPerc: 5,
+	}
+}
+
+func (p CodecProfile) Validate() error {
+	switch p.SampleRate {
+	case 8000, 12000, 16000, 24000, 48000:
+	default:
+		return fmt.Errorf("unsupported sample rate %d", p.SampleRate)
+	}
+	if p.Channels != 1 && p.Channels != 2 {
+		return fmt.Errorf("unsupported channel count %d", p.Channels)
+	}
+	if !validFrameSize(p.FrameSizeMs) {
+		return fmt.Errorf("unsupported frame size %d ms", p.FrameSizeMs)
+	}
+	if p.Bitrate < 0 {
+		return fmt.Errorf("invalid bitrate %d", p.Bitrate)
+	}
+	if p.Complexity < 0 || p.Complexity > 10 {
+		return fmt.Errorf("complexity must be between 0 and 10, not %d", p.Complexity)
+	}
+	if p.PacketLossPerc < 0 || p.PacketLossPerc > 100 {
+		return fmt.Errorf("packet loss percentage must be between 0 and 100, not %d", p.PacketLossPerc)
+	}
+	return nil
+}
+
+// decodeBufferSamples returns how many samples a decoder needs room for to decode a mono frame of the given
+// length. Zero means the length isn't known, so there has to be room for the longest possible opus packet.
+func decodeBufferSamples(frameSizeMs int) int {
+	if !validFrameSize(frameSizeMs) {
+		frameSizeMs = 120
+	}
+	return sampleRate * frameSizeMs / 1000
+}
+
+func validFrameSize(ms int) bool {
+	switch ms {
+	case 10, 20, 40, 60:
+		return true
+	}
+	return false
+}
+
+// frameSamples returns the number of samples in each frame, counting every channel.
+func (p CodecProfile) frameSamples() int {
+	return p.SampleRate * p.FrameSizeMs / 1000 * p.Channels
+}
+
+func (p CodecProfile) String() string {
+	bitrate := "auto"
+	if p.Bitrate > 0 {
+		bitrate = fmt.Sprintf("%d bps", p.Bitrate)
+	}
+	return fmt.Sprintf("%d Hz, %d channel(s), %d ms frames, bitrate %s, complexity %d, fec %v, dtx %v",
+		p.SampleRate, p.Channels, p.FrameSizeMs, bitrate, p.Complexity, p.InBandFEC, p.DTX)
+}
+
+func (p CodecProfile) newEncoder() (*opus.Encoder, error) {
+	enc, err := opus.NewEncoder(p.SampleRate, p.Channels, opus.AppVoIP)
+	if err != nil {
+		return nil, err
+	}
+	if p.Bitrate > 0 {
+		err = enc.SetBitrate(p.Bitrate)
+	} else {
+		err = enc.SetBitrateToAuto()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error setting bitrate: %w", err)
+	}
+	if err := enc.SetComplexity(p.Complexity); err != nil {
+		return nil, fmt.Errorf("error setting complexity: %w", err)
+	}
+	if err := enc.SetInBandFEC(p.InBandFEC); err != nil {
+		return nil, fmt.Errorf("error setting fec: %w", err)
+	}
+	if err := enc.SetPacketLossPerc(p.PacketLossPerc); err != nil {
+		return nil, fmt.Errorf("error setting packet loss percentage: %w", err)
+	}
+	if err := enc.SetDTX(p.DTX); err != nil {
+		return nil, fmt.Errorf("error setting dtx: %w", err)
+	}
+	return enc, nil
+}
@@ -51,17 +51,44 @@ func init() {
 }
 
 type InputDevice struct {
-	sampleRate int
-	opusEnc    *opus.Encoder
-	reader     audio.Reader
+	// the id from ListInputDevices, or empty if this is the system default
+	deviceID string
+
+	profile CodecProfile
+	opusEnc *opus.Encoder
+	track   *mediadevices.AudioTrack
+	reader  audio.Reader
 }
 
-// TODO: allow opening specific device by id
-func OpenInputDevice(sampleRate int) (*InputDevice, error) {
+// OpenInputDevice opens the input device with the given id, as returned by ListInputDevices,
+// and encodes what it captures with the given profile. If deviceID is empty, the system default device is used.
+func OpenInputDevice(profile CodecProfile, deviceID string) (*InputDevice, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+
+	// ListInputDevices gives out the malgo id, which mediadevices uses as the label,
+	// but the constraints need the mediadevices id.
+	var mediaDeviceID string
+	if deviceID != "" {
+		for _, dev := range mediadevices.EnumerateDevices() {
+			if dev.Kind == mediadevices.AudioInput && dev.Label == deviceID {
+				mediaDeviceID = dev.DeviceID
+				break
+			}
+		}
+		if mediaDeviceID == "" {
+			return nil, fmt.Errorf("no audio input device with id %s", deviceID)
+		}
+	}
+
 	stream, err := mediadevices.GetUserMedia(mediadevices.MediaStreamConstraints{
 		Audio: func(constraints *mediadevices.MediaTrackConstraints) {
-			constraints.ChannelCount = prop.Int(1)
-			constraints.SampleRate = prop.Int(sampleRate)
+			constraints.ChannelCount = prop.Int(profile.Channels)
+			constraints.SampleRate = prop.Int(profile.SampleRate)
+			if mediaDeviceID != "" {
+				constraints.DeviceID = prop.StringExact(mediaDeviceID)
+			}
 		},
 	})
 
@@ -72,19 +99,27 @@ func OpenInputDevice(sampleRate int) (*InputDevice, error) {
 	track := stream.GetAudioTracks()[0].(*mediadevices.AudioTrack)
 	reader := track.NewReader(false)
 
-	enc, err := opus.NewEncoder(sampleRate, 1, opus.AppVoIP)
+	enc, err := profile.newEncoder()
 	if err != nil {
+		track.Close()
 		return nil, err
 	}
 
 	dev := InputDevice{
-		sampleRate: sampleRate,
-		opusEnc:    enc,
-		reader:     reader,
+		deviceID: deviceID,
+		profile:  profile,
+		opusEnc:  enc,
+		track:    track,
+		reader:   reader,
 	}
 	return &dev, nil
 }
 
+// Close releases the device. It must not be called while a recording is reading from it.
+func (input *InputDevice) Close() error {
+	return input.track.Close()
+}
+
 func (input *InputDevice) ReadOpus(stopCh <-chan struct{}) <-chan []byte {
 	outCh := make(chan []byte, 1000)
 
@@ -93,48 +128,45 @@ func (input *InputDevice) ReadOpus(stopCh <-chan struct{}) <-chan []byte {
 	return outCh
 }
 
+// readOpus encodes the mic's audio into frames of the profile's size, whatever size of chunks the device
+// delivers it in. If the device fails, the error is logged and the channel is closed, ending the recording early.
 func (input *InputDevice) readOpus(stopCh <-chan struct{}, opusFrameCh chan []byte) {
-	const bufferSize = 1000
+	defer close(opusFrameCh)
+
+	// big enough for any opus packet the encoder will produce
+	const bufferSize = 4000
 
+	frameSamples := input.profile.frameSamples()
+	var pending []int16
 	for {
 		select {
 		case <-stopCh:
-			close(opusFrameCh)
 			return
 		default:
 		}
 
 		chunk, release, err := input.reader.Read()
 		if err != nil {
-			panic(err)
-		}
-		//fmt.Printf("read chunk: %v\n", chunk.ChunkInfo())
-
-		// convert to int16 and feed into opus encoder
-		pcm := getRawAudio(chunk)
-
-		// Check the frame size. You don't need to do this if you trust your input.
-		frameSize := len(pcm) // must be interleaved if stereo
-		frameSizeMs := float32(frameSize) * 1000 / float32(input.sampleRate)
-		switch frameSizeMs {
-		case 2.5, 5, 10, 20, 40, 60:
-			// Good.
-		default:
-			panic(fmt.Errorf("Illegal frame size: %d bytes (%f ms)", frameSize, frameSizeMs))
-		}
-
-		data := make([]byte, bufferSize)
-		n, err := input.opusEnc.Encode(pcm, data)
-		if err != nil {
-			panic(err)
+			fmt.Printf("error reading from audio input: %s\n", err)
+			return
 		}
-		data = data[:n] // only the first N bytes are opus data. Just like io.Reader.
-
+		// convert to int16, interleaved if stereo
+		pending = append(pending, getRawAudio(chunk, input.profile.Channels)...)
 		// release original sample chunk
 		release()
 
-		// send opus frame
-		opusFrameCh <- data
+		for len(pending) >= frameSamples {
+			data := make([]byte, bufferSize)
+			n, err := input.opusEnc.Encode(pending[:frameSamples], data)
+			if err != nil {
+				fmt.Printf("error encoding audio: %s\n", err)
+				return
+			}
+			pending = pending[frameSamples:]
+
+			// send opus frame
+			opusFrameCh <- data[:n] // only the first N bytes are opus data. Just like io.Reader.
+		}
 	}
 }
 
@@ -160,15 +192,21 @@ func ListInputDevices() []*types.InputDeviceInfo {
 	return devices
 }
 
-// getRawAudio converts a mediadevices Audio chunk into a buffer of int16 pcm samples
-func getRawAudio(a wave.Audio) []int16 {
+// getRawAudio converts a mediadevices Audio chunk into a buffer of int16 pcm samples, interleaving the
+// given number of channels. If the chunk has fewer channels than that, its first one is repeated.
+func getRawAudio(a wave.Audio, channels int) []int16 {
 	// TODO: buffer pool?
-	l := a.ChunkInfo().Len
-	pcm := make([]int16, l)
-	for i := 0; i < l; i++ {
-		orig := a.At(i, 0)
-		sample := wave.Int16SampleFormat.Convert(orig)
-		pcm[i] = int16(sample.(wave.Int16Sample))
+	info := a.ChunkInfo()
+	pcm := make([]int16, info.Len*channels)
+	for i := 0; i < info.Len; i++ {
+		for ch := 0; ch < channels; ch++ {
+			src := ch
+			if src >= info.Channels {
+				src = 0
+			}
+			sample := wave.Int16SampleFormat.Convert(a.At(i, src))
+			pcm[i*channels+ch] = int16(sample.(wave.Int16Sample))
+		}
 	}
 
 	return pcm
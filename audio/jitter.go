@@ -0,0 +1,274 @@
+package audio
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// how many frames each one is held back for after the earliest it could have arrived, to absorb uneven
+	// arrival times. With 20ms frames this adds 60ms of latency. Playback starts early if this many frames
+	// are already waiting.
+	jitterPrebufferFrames = 3
+
+	// used until the frame length can be worked out from the frames' timestamps
+	jitterDefaultFrameMs = 20
+
+	// gaps up to this many frames are filled in by the decoder. Concealing anything longer just sounds
+	// like a stuck note, so we skip straight to the next frame we have instead.
+	jitterMaxConcealFrames = 5
+)
+
+// JitterPacket is one step of a stream, as returned by JitterBuffer.Pop.
+type JitterPacket struct {
+	Seq uint32
+
+	// the opus frame, or nil if it was lost
+	Frame []byte
+
+	// if Frame is nil, this is the frame after it if it's already arrived. Its in-band FEC data
+	// may be able to recover the lost one.
+	Next []byte
+}
+
+// JitterStats describe how well a stream has been arriving.
+type JitterStats struct {
+	// frames that arrived in time to be played
+	Received uint64
+	// frames that arrived after their turn to play had passed
+	Late uint64
+	// frames that never arrived in time, including ones that turned up late
+	Lost uint64
+	// the smoothed variation in arrival times, as in RFC 3550
+	JitterMs float64
+	// frames waiting to be played
+	Buffered int
+}
+
+// JitterBuffer puts the frames of a live stream back in order by sequence number, and holds them back
+// for a short delay so they can be played at a steady pace even if they arrive unevenly.
+//
+// Each frame has a playout deadline: the local time its sender timestamp maps to, plus the prebuffer delay.
+// Timestamps are mapped using the quickest trip any frame has made so far. A frame that hasn't arrived by
+// its deadline is returned as lost, so the decoder can conceal it.
+type JitterBuffer struct {
+	lk   sync.Mutex
+	cond *sync.Cond
+
+	frames map[uint32]jitterFrame
+	// the sequence number of the next frame to play
+	next uint32
+
+	// the local time that sender timestamp zero maps to, taken from the frame with the shortest trip
+	clockZero time.Time
+	// a frame we know the timestamp of, to work out when the ones that are missing should have arrived
+	refSeq uint32
+	refTs  uint32
+	// the length of each frame, from the difference between timestamps
+	frameMs float64
+	// how many frames in a row have been returned as lost
+	lostRun int
+
+	started bool
+	primed  bool
+	closed  bool
+
+	// for the jitter estimate and frame length: the last frame that arrived, when, and its sender timestamp
+	lastArrival   time.Time
+	lastSeq       uint32
+	lastTimestamp uint32
+
+	stats JitterStats
+}
+
+type jitterFrame struct {
+	data        []byte
+	timestampMs uint32
+}
+
+func NewJitterBuffer() *JitterBuffer {
+	jb := &JitterBuffer{
+		frames:  make(map[uint32]jitterFrame),
+		frameMs: jitterDefaultFrameMs,
+	}
+	jb.cond = sync.NewCond(&jb.lk)
+	return jb
+}
+
+// Push adds a frame. timestampMs is when the sender captured it, relative to any fixed point in the stream.
+// Frames that arrive after their turn to play has passed are dropped.
+func (jb *JitterBuffer) Push(seq uint32, timestampMs uint32, frame []byte) {
+	jb.lk.Lock()
+	defer jb.lk.Unlock()
+
+	if jb.closed {
+		return
+	}
+	now := time.Now()
+	jb.updateClock(now, seq, timestampMs)
+	jb.updateJitter(now, seq, timestampMs)
+
+	if !jb.started || (!jb.primed && seq < jb.next) {
+		// until playback starts, an earlier frame can still arrive out of order
+		jb.next = seq
+		jb.started = true
+	} else if seq < jb.next {
+		jb.stats.Late++
+		return
+	}
+	if _, dup := jb.frames[seq]; dup {
+		return
+	}
+	jb.frames[seq] = jitterFrame{data: frame, timestampMs: timestampMs}
+	jb.stats.Received++
+	jb.cond.Broadcast()
+}
+
+// updateClock must be called with the lock held.
+func (jb *JitterBuffer) updateClock(now time.Time, seq uint32, timestampMs uint32) {
+	zero := now.Add(-time.Duration(timestampMs) * time.Millisecond)
+	if jb.clockZero.IsZero() || zero.Before(jb.clockZero) {
+		jb.clockZero = zero
+	}
+	if jb.lastArrival.IsZero() {
+		jb.refSeq = seq
+		jb.refTs = timestampMs
+	} else if seq > jb.lastSeq && timestampMs > jb.lastTimestamp {
+		ms := float64(timestampMs-jb.lastTimestamp) / float64(seq-jb.lastSeq)
+		// anything outside the possible opus frame lengths is a gap in the stream, not a frame
+		if ms >= 2.5 && ms <= 120 {
+			jb.frameMs = ms
+		}
+	}
+}
+
+// deadline returns when the frame with sequence number seq is due to be played.
+// It must be called with the lock held, once a frame has arrived.
+func (jb *JitterBuffer) deadline(seq uint32) time.Time {
+	ts := float64(jb.refTs) + float64(int64(seq)-int64(jb.refSeq))*jb.frameMs
+	delay := jitterPrebufferFrames * jb.frameMs
+	return jb.clockZero.Add(time.Duration((ts + delay) * float64(time.Millisecond)))
+}
+
+// updateJitter must be called with the lock held.
+func (jb *JitterBuffer) updateJitter(now time.Time, seq uint32, timestampMs uint32) {
+	if !jb.lastArrival.IsZero() {
+		// how much longer (or shorter) the gap between arrivals was than the gap between captures
+		arrivalGap := float64(now.Sub(jb.lastArrival)) / float64(time.Millisecond)
+		captureGap := float64(int64(timestampMs) - int64(jb.lastTimestamp))
+		d := math.Abs(arrivalGap - captureGap)
+		jb.stats.JitterMs += (d - jb.stats.JitterMs) / 16
+	}
+	jb.lastArrival = now
+	jb.lastSeq = seq
+	jb.lastTimestamp = timestampMs
+}
+
+// Close marks the end of the stream. Frames that are already buffered can still be popped.
+func (jb *JitterBuffer) Close() {
+	jb.lk.Lock()
+	defer jb.lk.Unlock()
+	jb.closed = true
+	jb.cond.Broadcast()
+}
+
+// Stats returns the stream's statistics so far.
+func (jb *JitterBuffer) Stats() JitterStats {
+	jb.lk.Lock()
+	defer jb.lk.Unlock()
+	stats := jb.stats
+	stats.Buffered = len(jb.frames)
+	return stats
+}
+
+// Pop blocks until the next step of the stream is ready, and returns it. If the next frame hasn't arrived by its
+// playout deadline, it's returned as lost. ok is false once the stream is closed and every frame has been popped.
+func (jb *JitterBuffer) Pop() (pkt JitterPacket, ok bool) {
+	jb.lk.Lock()
+	defer jb.lk.Unlock()
+
+	for {
+		if !jb.started {
+			if jb.closed {
+				return JitterPacket{}, false
+			}
+			jb.cond.Wait()
+			continue
+		}
+
+		if !jb.primed {
+			if len(jb.frames) < jitterPrebufferFrames && !jb.closed && jb.waitUntil(jb.deadline(jb.next)) {
+				continue
+			}
+			jb.primed = true
+		}
+
+		if f, found := jb.frames[jb.next]; found {
+			delete(jb.frames, jb.next)
+			pkt = JitterPacket{Seq: jb.next, Frame: f.data}
+			// later frames are timed from this one, in case the frame length changes
+			jb.refSeq = jb.next
+			jb.refTs = f.timestampMs
+			jb.lostRun = 0
+			jb.next++
+			return pkt, true
+		}
+		if len(jb.frames) == 0 && jb.closed {
+			return JitterPacket{}, false
+		}
+		if !jb.closed && jb.waitUntil(jb.deadline(jb.next)) {
+			continue
+		}
+
+		// the next frame is lost
+		if len(jb.frames) > 0 {
+			earliest := jb.earliest()
+			if gap := earliest - jb.next; gap > jitterMaxConcealFrames {
+				jb.stats.Lost += uint64(gap)
+				jb.next = earliest
+				continue
+			}
+		} else if jb.lostRun >= jitterMaxConcealFrames {
+			// nothing's arriving, so stop concealing and wait for the stream to pick up again.
+			// Once it does, the frames in between are skipped over.
+			jb.cond.Wait()
+			continue
+		}
+		pkt = JitterPacket{Seq: jb.next, Next: jb.frames[jb.next+1].data}
+		jb.stats.Lost++
+		jb.lostRun++
+		jb.next++
+		return pkt, true
+	}
+}
+
+// waitUntil waits for a frame to arrive or the stream to close, until t at the latest. It returns false
+// without waiting if t has already passed. It must be called with the lock held.
+func (jb *JitterBuffer) waitUntil(t time.Time) bool {
+	d := time.Until(t)
+	if d <= 0 {
+		return false
+	}
+	timer := time.AfterFunc(d, func() {
+		jb.lk.Lock()
+		jb.cond.Broadcast()
+		jb.lk.Unlock()
+	})
+	jb.cond.Wait()
+	timer.Stop()
+	return true
+}
+
+// earliest must be called with the lock held, and at least one frame buffered.
+func (jb *JitterBuffer) earliest() uint32 {
+	first := true
+	var min uint32
+	for seq := range jb.frames {
+		if first || seq < min {
+			min = seq
+			first = false
+		}
+	}
+	return min
+}
@@ -0,0 +1,184 @@
+package audio
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func frameFor(seq uint32) []byte {
+	return []byte{byte(seq)}
+}
+
+// pushFrames pushes 20ms frames with the given sequence numbers, timestamped to match.
+func pushFrames(jb *JitterBuffer, seqs ...uint32) {
+	for _, seq := range seqs {
+		jb.Push(seq, seq*20, frameFor(seq))
+	}
+}
+
+func mustPop(t *testing.T, jb *JitterBuffer) JitterPacket {
+	t.Helper()
+	type result struct {
+		pkt JitterPacket
+		ok  bool
+	}
+	ch := make(chan result, 1)
+	go func() {
+		pkt, ok := jb.Pop()
+		ch <- result{pkt, ok}
+	}()
+	select {
+	case r := <-ch:
+		if !r.ok {
+			t.Fatalf("expected a packet, but the stream ended")
+		}
+		return r.pkt
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timed out waiting for a packet")
+	}
+	return JitterPacket{}
+}
+
+func expectFrame(t *testing.T, pkt JitterPacket, seq uint32) {
+	t.Helper()
+	if pkt.Seq != seq || !bytes.Equal(pkt.Frame, frameFor(seq)) {
+		t.Fatalf("expected frame %d, got seq %d with frame %v", seq, pkt.Seq, pkt.Frame)
+	}
+}
+
+func expectEnd(t *testing.T, jb *JitterBuffer) {
+	t.Helper()
+	if pkt, ok := jb.Pop(); ok {
+		t.Fatalf("expected the stream to have ended, got seq %d", pkt.Seq)
+	}
+}
+
+func TestJitterBufferReorders(t *testing.T) {
+	jb := NewJitterBuffer()
+	pushFrames(jb, 2, 0, 1, 4, 3)
+	jb.Close()
+
+	for seq := uint32(0); seq < 5; seq++ {
+		expectFrame(t, mustPop(t, jb), seq)
+	}
+	expectEnd(t, jb)
+
+	stats := jb.Stats()
+	if stats.Received != 5 || stats.Lost != 0 || stats.Late != 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestJitterBufferDropsLateFrames(t *testing.T) {
+	jb := NewJitterBuffer()
+	pushFrames(jb, 0, 1, 2)
+	for seq := uint32(0); seq < 3; seq++ {
+		expectFrame(t, mustPop(t, jb), seq)
+	}
+
+	pushFrames(jb, 1)
+	if stats := jb.Stats(); stats.Late != 1 || stats.Buffered != 0 {
+		t.Fatalf("expected the frame to be dropped as late, got stats %+v", stats)
+	}
+}
+
+func TestJitterBufferDeclaresLoss(t *testing.T) {
+	jb := NewJitterBuffer()
+	pushFrames(jb, 0, 1, 2, 4, 5)
+	for seq := uint32(0); seq < 3; seq++ {
+		expectFrame(t, mustPop(t, jb), seq)
+	}
+
+	pkt := mustPop(t, jb)
+	if pkt.Seq != 3 || pkt.Frame != nil {
+		t.Fatalf("expected frame 3 to be lost, got seq %d with frame %v", pkt.Seq, pkt.Frame)
+	}
+	if !bytes.Equal(pkt.Next, frameFor(4)) {
+		t.Fatalf("expected the lost frame to come with the next one, got %v", pkt.Next)
+	}
+
+	expectFrame(t, mustPop(t, jb), 4)
+	expectFrame(t, mustPop(t, jb), 5)
+	if stats := jb.Stats(); stats.Lost != 1 {
+		t.Fatalf("expected 1 lost frame, got stats %+v", stats)
+	}
+}
+
+func TestJitterBufferWaitsForDeadline(t *testing.T) {
+	start := time.Now()
+	jb := NewJitterBuffer()
+	pushFrames(jb, 0, 1, 2)
+	for seq := uint32(0); seq < 3; seq++ {
+		expectFrame(t, mustPop(t, jb), seq)
+	}
+
+	// frame 2 arrived straight away, so frame 3 is due 20ms later, plus the 60ms prebuffer delay
+	pkt := mustPop(t, jb)
+	if pkt.Seq != 3 || pkt.Frame != nil {
+		t.Fatalf("expected frame 3 to be lost, got seq %d with frame %v", pkt.Seq, pkt.Frame)
+	}
+	if elapsed := time.Since(start); elapsed < 70*time.Millisecond {
+		t.Fatalf("frame 3 was declared lost after %s, before its deadline", elapsed)
+	}
+}
+
+func TestJitterBufferPlaysFramesThatArriveBeforeDeadline(t *testing.T) {
+	jb := NewJitterBuffer()
+	pushFrames(jb, 0, 1, 2, 4)
+	for seq := uint32(0); seq < 3; seq++ {
+		expectFrame(t, mustPop(t, jb), seq)
+	}
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		pushFrames(jb, 3)
+	}()
+	expectFrame(t, mustPop(t, jb), 3)
+	expectFrame(t, mustPop(t, jb), 4)
+}
+
+func TestJitterBufferSkipsLongGaps(t *testing.T) {
+	jb := NewJitterBuffer()
+	pushFrames(jb, 0, 1, 2, 10)
+	for seq := uint32(0); seq < 3; seq++ {
+		expectFrame(t, mustPop(t, jb), seq)
+	}
+
+	// concealing 7 frames would sound worse than skipping them
+	expectFrame(t, mustPop(t, jb), 10)
+	if stats := jb.Stats(); stats.Lost != 7 {
+		t.Fatalf("expected 7 lost frames, got stats %+v", stats)
+	}
+}
+
+func TestJitterBufferClose(t *testing.T) {
+	jb := NewJitterBuffer()
+	pushFrames(jb, 0)
+	jb.Close()
+	pushFrames(jb, 1)
+
+	// buffered frames are still played, without waiting for the prebuffer to fill
+	expectFrame(t, mustPop(t, jb), 0)
+	expectEnd(t, jb)
+}
+
+func TestJitterBufferCloseUnblocksPop(t *testing.T) {
+	jb := NewJitterBuffer()
+	done := make(chan bool)
+	go func() {
+		_, ok := jb.Pop()
+		done <- ok
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	jb.Close()
+	select {
+	case ok := <-done:
+		if ok {
+			t.Fatalf("expected Pop to report the end of the stream")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Pop didn't return after Close")
+	}
+}
@@ -0,0 +1,170 @@
+package audio
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/yusefnapora/party-line/types"
+)
+
+// how many ended streams to keep stats for
+const maxEndedLiveStats = 20
+
+// LiveStream plays opus frames from a peer as they arrive, e.g. push-to-talk audio.
+type LiveStream struct {
+	store  *Store
+	peerID string
+	talkID string
+	jitter *JitterBuffer
+	source *MixerSource
+
+	statsLk sync.Mutex
+	// lost frames that were rebuilt with FEC, or filled in with packet loss concealment
+	recovered uint64
+	concealed uint64
+
+	done chan struct{}
+}
+
+// OpenLiveStream starts playing a new live stream from the given peer, mixed with anything else that's playing.
+// Its volume follows the peer's gain, set with SetPeerGain. Frames are added with Push.
+func (s *Store) OpenLiveStream(peerID string, talkID string) (*LiveStream, error) {
+	s.liveLk.Lock()
+	defer s.liveLk.Unlock()
+
+	src, err := s.openSource(s.peerGain(peerID), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ls := &LiveStream{
+		store:  s,
+		peerID: peerID,
+		talkID: talkID,
+		jitter: NewJitterBuffer(),
+		source: src,
+		done:   make(chan struct{}),
+	}
+	s.liveStreams[ls] = struct{}{}
+	go ls.playLoop()
+	return ls, nil
+}
+
+// Push adds the frame with the given sequence number to the stream. timestampMs is when the sender captured it.
+func (ls *LiveStream) Push(seq uint32, timestampMs uint32, frame []byte) {
+	ls.jitter.Push(seq, timestampMs, frame)
+}
+
+// Close ends the stream, and waits for the frames that are already buffered to be played.
+func (ls *LiveStream) Close() {
+	ls.jitter.Close()
+	<-ls.done
+}
+
+func (ls *LiveStream) playLoop() {
+	defer close(ls.done)
+	defer ls.store.liveStreamEnded(ls)
+
+	for {
+		pkt, ok := ls.jitter.Pop()
+		if !ok {
+			return
+		}
+		if pkt.Frame != nil {
+			if err := ls.source.WriteOpus(pkt.Frame); err != nil {
+				fmt.Printf("error decoding live audio: %s\n", err)
+			}
+			continue
+		}
+
+		fec, err := ls.source.WriteLost(pkt.Next)
+		if err != nil {
+			fmt.Printf("error concealing lost live audio frame %d: %s\n", pkt.Seq, err)
+			continue
+		}
+		ls.statsLk.Lock()
+		if fec {
+			ls.recovered++
+		} else {
+			ls.concealed++
+		}
+		ls.statsLk.Unlock()
+	}
+}
+
+// Stats describe how well the stream is arriving.
+func (ls *LiveStream) Stats() *types.LiveStreamStats {
+	js := ls.jitter.Stats()
+	ls.statsLk.Lock()
+	defer ls.statsLk.Unlock()
+	return &types.LiveStreamStats{
+		PeerId:         ls.peerID,
+		TalkId:         ls.talkID,
+		Received:       js.Received,
+		Late:           js.Late,
+		Lost:           js.Lost,
+		Recovered:      ls.recovered,
+		Concealed:      ls.concealed,
+		JitterMs:       js.JitterMs,
+		BufferedFrames: uint32(js.Buffered),
+	}
+}
+
+func (s *Store) liveStreamEnded(ls *LiveStream) {
+	ls.source.Close()
+	stats := ls.Stats()
+
+	s.liveLk.Lock()
+	defer s.liveLk.Unlock()
+	delete(s.liveStreams, ls)
+	s.endedLiveStats = append(s.endedLiveStats, stats)
+	if len(s.endedLiveStats) > maxEndedLiveStats {
+		s.endedLiveStats = s.endedLiveStats[1:]
+	}
+}
+
+// LiveStreamStats returns the stats of the live streams that are playing, followed by the most recent
+// ones that have ended, oldest first.
+func (s *Store) LiveStreamStats() []*types.LiveStreamStats {
+	s.liveLk.Lock()
+	defer s.liveLk.Unlock()
+
+	var all []*types.LiveStreamStats
+	for ls := range s.liveStreams {
+		stats := ls.Stats()
+		stats.Active = true
+		all = append(all, stats)
+	}
+	return append(all, s.endedLiveStats...)
+}
+
+// SetPeerGain scales the volume of live audio from a peer, on top of the master gain. 1 is unchanged.
+// It applies to streams that are already playing, too.
+func (s *Store) SetPeerGain(peerID string, gain float64) error {
+	if gain < 0 || math.IsNaN(gain) || math.IsInf(gain, 0) {
+		return fmt.Errorf("invalid gain %v", gain)
+	}
+
+	s.liveLk.Lock()
+	defer s.liveLk.Unlock()
+	if gain == 1 {
+		delete(s.peerGains, peerID)
+	} else {
+		s.peerGains[peerID] = gain
+	}
+	for ls := range s.liveStreams {
+		if ls.peerID == peerID {
+			ls.source.SetGain(gain)
+		}
+	}
+	return nil
+}
+
+// peerGain must be called with liveLk held.
+func (s *Store) peerGain(peerID string) float64 {
+	if gain, ok := s.peerGains[peerID]; ok {
+		return gain
+	}
+	return 1
+}
@@ -0,0 +1,216 @@
+package audio
+
+import (
+	"math"
+	"sync"
+
+	"gopkg.in/hraban/opus.v2"
+)
+
+const (
+	// the mixer combines this much audio from each source at a time
+	mixerTickMs = 20
+
+	// how much decoded audio a source can hold before Write blocks. With 20ms ticks this is 100ms.
+	mixerSourceMaxTicks = 5
+)
+
+// Mixer plays several streams of audio at once, e.g. a recording and a couple of peers talking.
+// Every 20ms tick it takes the next chunk of audio from each source, scales it by the source's gain,
+// and sums the chunks into one, clipping samples that would overflow. The result goes to output.
+//
+// The mixer keeps pace with the output: output is expected to block while the device has enough queued,
+// and sources in turn block their writers while they're full.
+type Mixer struct {
+	sampleRate  int
+	tickSamples int
+	output      func(pcm []int16)
+
+	lk      sync.Mutex
+	cond    *sync.Cond
+	sources map[*MixerSource]struct{}
+}
+
+// MixerSource is one stream of audio going into a Mixer. Only one goroutine should write to it at a time.
+type MixerSource struct {
+	mixer   *Mixer
+	opusDec *opus.Decoder
+	decoded []int16
+
+	// these are guarded by the mixer's lock
+	pcm    []int16
+	gain   float64
+	closed bool
+}
+
+func NewMixer(sampleRate int, output func(pcm []int16)) *Mixer {
+	m := &Mixer{
+		sampleRate:  sampleRate,
+		tickSamples: sampleRate * mixerTickMs / 1000,
+		output:      output,
+		sources:     make(map[*MixerSource]struct{}),
+	}
+	m.cond = sync.NewCond(&m.lk)
+	go m.run()
+	return m
+}
+
+// AddSource adds a new source, whose volume is scaled by gain. 1 is unchanged. frameSizeMs is the length of the
+// opus frames that will be written to it, or zero if it isn't known; frames longer than that fail to decode.
+// Close the source when it's done, so the mixer stops waiting on it.
+func (m *Mixer) AddSource(gain float64, frameSizeMs int) (*MixerSource, error) {
+	// each source needs its own decoder, since opus decoding depends on the frames that came before
+	dec, err := opus.NewDecoder(m.sampleRate, 1)
+	if err != nil {
+		return nil, err
+	}
+	src := &MixerSource{
+		mixer:   m,
+		opusDec: dec,
+		decoded: make([]int16, decodeBufferSamples(frameSizeMs)),
+		gain:    gain,
+	}
+
+	m.lk.Lock()
+	m.sources[src] = struct{}{}
+	m.lk.Unlock()
+	return src, nil
+}
+
+// WriteOpus decodes an opus frame and queues it to be mixed. It blocks while the source is full.
+func (src *MixerSource) WriteOpus(frame []byte) error {
+	n, err := src.opusDec.Decode(frame, src.decoded)
+	if err != nil {
+		return err
+	}
+	src.Write(src.decoded[:n])
+	return nil
+}
+
+// WriteLost fills in for a frame that never arrived. If next is the frame after it, its in-band FEC data
+// is used to recover the lost one if it has any; otherwise the decoder conceals the gap based on the audio
+// before it. It returns true if FEC was used.
+func (src *MixerSource) WriteLost(next []byte) (bool, error) {
+	// the lost frame is assumed to be as long as the last one
+	n, err := src.opusDec.LastPacketDuration()
+	if err != nil {
+		return false, err
+	}
+	if n <= 0 || n > len(src.decoded) {
+		// nothing's been decoded yet, so there's nothing to go on
+		return false, nil
+	}
+	// the decoder goes by the buffer's capacity, so it has to be exactly as long as the missing audio
+	pcm := src.decoded[:n:n]
+
+	fec := len(next) > 0
+	if fec {
+		err = src.opusDec.DecodeFEC(next, pcm)
+	} else {
+		err = src.opusDec.DecodePLC(pcm)
+	}
+	if err != nil {
+		return false, err
+	}
+	src.Write(pcm)
+	return fec, nil
+}
+
+// Write queues 16-bit mono samples to be mixed. It blocks while the source is full.
+func (src *MixerSource) Write(pcm []int16) {
+	m := src.mixer
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	for len(src.pcm) >= mixerSourceMaxTicks*m.tickSamples && !src.closed {
+		m.cond.Wait()
+	}
+	if src.closed {
+		return
+	}
+	src.pcm = append(src.pcm, pcm...)
+	m.cond.Broadcast()
+}
+
+// SetGain changes the volume of the source, starting with the next tick.
+func (src *MixerSource) SetGain(gain float64) {
+	src.mixer.lk.Lock()
+	defer src.mixer.lk.Unlock()
+	src.gain = gain
+}
+
+// Flush drops the audio that's waiting to be mixed, e.g. after seeking.
+func (src *MixerSource) Flush() {
+	src.mixer.lk.Lock()
+	defer src.mixer.lk.Unlock()
+	src.pcm = nil
+	src.mixer.cond.Broadcast()
+}
+
+// Close removes the source from the mixer once the audio it already has is played.
+// Call Flush first to stop right away.
+func (src *MixerSource) Close() {
+	m := src.mixer
+	m.lk.Lock()
+	defer m.lk.Unlock()
+	src.closed = true
+	if len(src.pcm) == 0 {
+		delete(m.sources, src)
+	}
+	m.cond.Broadcast()
+}
+
+func (m *Mixer) run() {
+	mixed := make([]float64, m.tickSamples)
+	out := make([]int16, m.tickSamples)
+	for {
+		m.lk.Lock()
+		for !m.hasAudio() {
+			m.cond.Wait()
+		}
+
+		for i := range mixed {
+			mixed[i] = 0
+		}
+		n := 0
+		for src := range m.sources {
+			take := len(src.pcm)
+			if take > m.tickSamples {
+				take = m.tickSamples
+			}
+			for i, s := range src.pcm[:take] {
+				mixed[i] += float64(s) * src.gain
+			}
+			if take > n {
+				n = take
+			}
+			src.pcm = src.pcm[take:]
+			if src.closed && len(src.pcm) == 0 {
+				delete(m.sources, src)
+			}
+		}
+		// wake up writers that were waiting for room
+		m.cond.Broadcast()
+		m.lk.Unlock()
+
+		for i, v := range mixed[:n] {
+			if v > math.MaxInt16 {
+				v = math.MaxInt16
+			} else if v < math.MinInt16 {
+				v = math.MinInt16
+			}
+			out[i] = int16(v)
+		}
+		m.output(out[:n])
+	}
+}
+
+// hasAudio must be called with the lock held.
+func (m *Mixer) hasAudio() bool {
+	for src := range m.sources {
+		if len(src.pcm) > 0 {
+			return true
+		}
+	}
+	return false
+}
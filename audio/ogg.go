@@ -0,0 +1,326 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+)
+
+// Ogg Opus container support (RFC 7845), so recordings can be saved & opened by other players.
+
+const (
+	oggPageHeaderSize = 27
+	oggMaxSegments    = 255
+
+	oggHeaderTypeContinued = 0x01
+	oggHeaderTypeBOS       = 0x02
+	oggHeaderTypeEOS       = 0x04
+
+	// libopus' lookahead at 48 kHz, which players should skip at the start of the stream
+	opusPreSkip = 312
+
+	// how many opus packets to put on each ogg page. 50 * 20ms frames is about a second of audio.
+	oggPacketsPerPage = 50
+
+	oggVendor = "party-line"
+)
+
+var oggCRCTable = makeOggCRCTable()
+
+// ogg uses a CRC32 with polynomial 0x04c11db7, no reflection and a zero initial value,
+// which isn't one of the variants in hash/crc32.
+func makeOggCRCTable() *[256]uint32 {
+	var table [256]uint32
+	for i := range table {
+		r := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if r&0x80000000 != 0 {
+				r = (r << 1) ^ 0x04c11db7
+			} else {
+				r <<= 1
+			}
+		}
+		table[i] = r
+	}
+	return &table
+}
+
+func oggCRC(data []byte) uint32 {
+	var crc uint32
+	for _, b := range data {
+		crc = (crc << 8) ^ oggCRCTable[byte(crc>>24)^b]
+	}
+	return crc
+}
+
+// opusPacketSamples returns the duration of an opus packet in samples at 48 kHz, using its TOC byte (RFC 6716 section 3.1).
+func opusPacketSamples(packet []byte) (int, error) {
+	if len(packet) < 1 {
+		return 0, errors.New("empty opus packet")
+	}
+	toc := packet[0]
+	config := toc >> 3
+
+	// frame durations in units of 1/400 second (2.5ms)
+	var frameUnits int
+	switch {
+	case config < 12: // SILK
+		frameUnits = []int{4, 8, 16, 24}[config%4]
+	case config < 16: // hybrid
+		frameUnits = []int{4, 8}[config%2]
+	default: // CELT
+		frameUnits = []int{1, 2, 4, 8}[config%4]
+	}
+
+	var frames int
+	switch toc & 0x3 {
+	case 0:
+		frames = 1
+	case 1, 2:
+		frames = 2
+	default:
+		if len(packet) < 2 {
+			return 0, errors.New("opus packet is missing its frame count")
+		}
+		frames = int(packet[1] & 0x3f)
+	}
+	return frames * frameUnits * 48000 / 400, nil
+}
+
+// oggPageWriter writes a single logical ogg stream.
+type oggPageWriter struct {
+	w      io.Writer
+	serial uint32
+	seq    uint32
+}
+
+func (ow *oggPageWriter) writePage(packets [][]byte, granule uint64, headerType byte) error {
+	var segments []byte
+	var body []byte
+	for _, p := range packets {
+		n := len(p)
+		for n >= 255 {
+			segments = append(segments, 255)
+			n -= 255
+		}
+		// a final segment shorter than 255 (possibly zero) marks the end of the packet
+		segments = append(segments, byte(n))
+		body = append(body, p...)
+	}
+	if len(segments) > oggMaxSegments {
+		return fmt.Errorf("too many segments for one ogg page: %d", len(segments))
+	}
+
+	page := make([]byte, oggPageHeaderSize, oggPageHeaderSize+len(segments)+len(body))
+	copy(page, "OggS")
+	page[4] = 0 // version
+	page[5] = headerType
+	binary.LittleEndian.PutUint64(page[6:], granule)
+	binary.LittleEndian.PutUint32(page[14:], ow.serial)
+	binary.LittleEndian.PutUint32(page[18:], ow.seq)
+	page[26] = byte(len(segments))
+	page = append(page, segments...)
+	page = append(page, body...)
+	binary.LittleEndian.PutUint32(page[22:], oggCRC(page))
+
+	ow.seq++
+	_, err := ow.w.Write(page)
+	return err
+}
+
+// WriteOgg writes the recording to w as an Ogg Opus file.
+func (r *Recording) WriteOgg(w io.Writer) error {
+	ow := &oggPageWriter{w: w, serial: rand.Uint32()}
+
+	head := make([]byte, 19)
+	copy(head, "OpusHead")
+	head[8] = 1 // version
+	head[9] = byte(r.channels())
+	binary.LittleEndian.PutUint16(head[10:], opusPreSkip)
+	binary.LittleEndian.PutUint32(head[12:], sampleRate)
+	// output gain & channel mapping family are left at zero
+	if err := ow.writePage([][]byte{head}, 0, oggHeaderTypeBOS); err != nil {
+		return err
+	}
+
+	tags := make([]byte, 8+4+len(oggVendor)+4)
+	copy(tags, "OpusTags")
+	binary.LittleEndian.PutUint32(tags[8:], uint32(len(oggVendor)))
+	copy(tags[12:], oggVendor)
+	// no user comments, so the trailing comment count stays zero
+	if err := ow.writePage([][]byte{tags}, 0, 0); err != nil {
+		return err
+	}
+
+	// the granule position of a page is the number of samples decoded once all of its packets
+	// are done, counting the pre-skip samples
+	granule := uint64(opusPreSkip)
+	var packets [][]byte
+	segments := 0
+	for i, frame := range r.Frames {
+		samples, err := opusPacketSamples(frame)
+		if err != nil {
+			return fmt.Errorf("frame %d: %w", i, err)
+		}
+
+		frameSegments := len(frame)/255 + 1
+		if len(packets) == oggPacketsPerPage || segments+frameSegments > oggMaxSegments {
+			if err := ow.writePage(packets, granule, 0); err != nil {
+				return err
+			}
+			packets = nil
+			segments = 0
+		}
+		packets = append(packets, frame)
+		segments += frameSegments
+		granule += uint64(samples)
+	}
+	// the last page must be marked as the end of stream, even if it's empty
+	return ow.writePage(packets, granule, oggHeaderTypeEOS)
+}
+
+// readOggPackets returns the packets of the first logical stream in an ogg file.
+func readOggPackets(r io.Reader) ([][]byte, error) {
+	var packets [][]byte
+	var partial []byte
+	var serial uint32
+	first := true
+
+	header := make([]byte, oggPageHeaderSize)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err == io.EOF && !first {
+				return packets, nil
+			}
+			return nil, fmt.Errorf("error reading ogg page: %w", err)
+		}
+		if !bytes.Equal(header[:4], []byte("OggS")) {
+			return nil, errors.New("not an ogg file")
+		}
+
+		segments := make([]byte, header[26])
+		if _, err := io.ReadFull(r, segments); err != nil {
+			return nil, fmt.Errorf("error reading ogg page: %w", err)
+		}
+		bodySize := 0
+		for _, s := range segments {
+			bodySize += int(s)
+		}
+		body := make([]byte, bodySize)
+		if _, err := io.ReadFull(r, body); err != nil {
+			return nil, fmt.Errorf("error reading ogg page: %w", err)
+		}
+
+		crc := binary.LittleEndian.Uint32(header[22:])
+		binary.LittleEndian.PutUint32(header[22:], 0)
+		page := append(append(append([]byte{}, header...), segments...), body...)
+		if oggCRC(page) != crc {
+			return nil, errors.New("ogg page checksum mismatch")
+		}
+
+		pageSerial := binary.LittleEndian.Uint32(header[14:])
+		if first {
+			serial = pageSerial
+			first = false
+		} else if pageSerial != serial {
+			// some other multiplexed stream; we only want the first one
+			continue
+		}
+		if header[5]&oggHeaderTypeContinued == 0 {
+			partial = nil
+		}
+
+		offset := 0
+		for _, s := range segments {
+			partial = append(partial, body[offset:offset+int(s)]...)
+			offset += int(s)
+			if s < 255 {
+				packets = append(packets, partial)
+				partial = nil
+			}
+		}
+
+		if header[5]&oggHeaderTypeEOS != 0 {
+			return packets, nil
+		}
+	}
+}
+
+// RecordingFromOgg reads the opus packets from an Ogg Opus file into a new recording with the given id.
+func RecordingFromOgg(id string, r io.Reader) (*Recording, error) {
+	packets, err := readOggPackets(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(packets) < 2 {
+		return nil, errors.New("ogg file is missing the opus headers")
+	}
+
+	head := packets[0]
+	if len(head) < 19 || !bytes.Equal(head[:8], []byte("OpusHead")) {
+		return nil, errors.New("not an ogg opus file")
+	}
+	if channels := head[9]; channels > 2 {
+		return nil, fmt.Errorf("unsupported opus channel count: %d", channels)
+	}
+	if !bytes.HasPrefix(packets[1], []byte("OpusTags")) {
+		return nil, errors.New("ogg opus file is missing its comment header")
+	}
+
+	rec := &Recording{ID: id}
+	for _, p := range packets[2:] {
+		if len(p) == 0 {
+			continue
+		}
+		rec.Frames = append(rec.Frames, p)
+	}
+	rec.FrameSizeMs = rec.uniformFrameSizeMs()
+	return rec, nil
+}
+
+// uniformFrameSizeMs returns the length of the recording's frames if they're all the same and it's one we
+// record with ourselves, or zero otherwise.
+func (r *Recording) uniformFrameSizeMs() int {
+	size := 0
+	for i, frame := range r.Frames {
+		n, err := opusPacketSamples(frame)
+		if err != nil {
+			return 0
+		}
+		ms := n * 1000 / 48000
+		if i > 0 && ms != size {
+			return 0
+		}
+		size = ms
+	}
+	if !validFrameSize(size) {
+		return 0
+	}
+	return size
+}
+
+// channels returns 2 if any frame of the recording is stereo, or 1 otherwise, based on the TOC bytes.
+func (r *Recording) channels() int {
+	for _, frame := range r.Frames {
+		if len(frame) > 0 && frame[0]&0x4 != 0 {
+			return 2
+		}
+	}
+	return 1
+}
+
+// DurationMs returns the length of the recording, based on the TOC byte of each frame.
+func (r *Recording) DurationMs() int64 {
+	var samples int64
+	for _, frame := range r.Frames {
+		n, err := opusPacketSamples(frame)
+		if err != nil {
+			continue
+		}
+		samples += int64(n)
+	}
+	return samples * 1000 / 48000
+}
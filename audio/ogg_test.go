@@ -0,0 +1,157 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// a CELT-only TOC byte for one 20ms frame
+const testTOC20ms = 31 << 3
+
+func testFrame(size int, fill byte) []byte {
+	frame := bytes.Repeat([]byte{fill}, size)
+	frame[0] = testTOC20ms
+	return frame
+}
+
+type testOggPage struct {
+	headerType byte
+	granule    uint64
+	seq        uint32
+	segments   []byte
+}
+
+// parseOggPages splits an ogg file into pages, without reassembling packets.
+func parseOggPages(t *testing.T, data []byte) []testOggPage {
+	t.Helper()
+	var pages []testOggPage
+	for len(data) > 0 {
+		if len(data) < oggPageHeaderSize || !bytes.Equal(data[:4], []byte("OggS")) {
+			t.Fatalf("bad ogg page header at page %d", len(pages))
+		}
+		n := int(data[26])
+		segments := data[oggPageHeaderSize : oggPageHeaderSize+n]
+		size := oggPageHeaderSize + n
+		for _, s := range segments {
+			size += int(s)
+		}
+		pages = append(pages, testOggPage{
+			headerType: data[5],
+			granule:    binary.LittleEndian.Uint64(data[6:]),
+			seq:        binary.LittleEndian.Uint32(data[18:]),
+			segments:   segments,
+		})
+		data = data[size:]
+	}
+	return pages
+}
+
+func writeTestOgg(t *testing.T, rec *Recording) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := rec.WriteOgg(&buf); err != nil {
+		t.Fatalf("error writing ogg: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func TestOggRoundTrip(t *testing.T) {
+	rec := &Recording{ID: "test"}
+	for i := 0; i < 120; i++ {
+		rec.Frames = append(rec.Frames, testFrame(10+i, byte(i)))
+	}
+
+	got, err := RecordingFromOgg("imported", bytes.NewReader(writeTestOgg(t, rec)))
+	if err != nil {
+		t.Fatalf("error reading ogg: %s", err)
+	}
+	if len(got.Frames) != len(rec.Frames) {
+		t.Fatalf("expected %d frames, got %d", len(rec.Frames), len(got.Frames))
+	}
+	for i := range rec.Frames {
+		if !bytes.Equal(got.Frames[i], rec.Frames[i]) {
+			t.Fatalf("frame %d doesn't match", i)
+		}
+	}
+	if got.ID != "imported" || got.FrameSizeMs != 20 {
+		t.Fatalf("expected id imported with 20ms frames, got %q with %dms", got.ID, got.FrameSizeMs)
+	}
+}
+
+func TestOggLacingOfMultiplesOf255(t *testing.T) {
+	rec := &Recording{ID: "test", Frames: [][]byte{
+		testFrame(255, 1),
+		testFrame(510, 2),
+		testFrame(254, 3),
+	}}
+	data := writeTestOgg(t, rec)
+
+	pages := parseOggPages(t, data)
+	if len(pages) != 3 {
+		t.Fatalf("expected 3 pages, got %d", len(pages))
+	}
+	// packets that fill their last segment exactly need a zero-length segment to end them
+	expected := []byte{255, 0, 255, 255, 0, 254}
+	if !bytes.Equal(pages[2].segments, expected) {
+		t.Fatalf("expected segments %v, got %v", expected, pages[2].segments)
+	}
+
+	got, err := RecordingFromOgg("test", bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("error reading ogg: %s", err)
+	}
+	if len(got.Frames) != 3 {
+		t.Fatalf("expected 3 frames, got %d", len(got.Frames))
+	}
+	for i := range rec.Frames {
+		if !bytes.Equal(got.Frames[i], rec.Frames[i]) {
+			t.Fatalf("frame %d doesn't match", i)
+		}
+	}
+}
+
+func TestOggGranulePositions(t *testing.T) {
+	rec := &Recording{ID: "test"}
+	for i := 0; i < 120; i++ {
+		rec.Frames = append(rec.Frames, testFrame(20, byte(i)))
+	}
+	pages := parseOggPages(t, writeTestOgg(t, rec))
+
+	// two header pages, then 50 packets per page
+	if len(pages) != 5 {
+		t.Fatalf("expected 5 pages, got %d", len(pages))
+	}
+	if pages[0].headerType != oggHeaderTypeBOS || pages[0].granule != 0 || pages[1].granule != 0 {
+		t.Fatalf("unexpected header pages %+v", pages[:2])
+	}
+	for i, page := range pages {
+		if page.seq != uint32(i) {
+			t.Fatalf("page %d has sequence number %d", i, page.seq)
+		}
+	}
+
+	// each 20ms frame is 960 samples at 48 kHz, on top of the pre-skip
+	expected := []uint64{opusPreSkip + 50*960, opusPreSkip + 100*960, opusPreSkip + 120*960}
+	for i, granule := range expected {
+		if got := pages[i+2].granule; got != granule {
+			t.Fatalf("expected page %d to have granule %d, got %d", i+2, granule, got)
+		}
+	}
+	last := pages[len(pages)-1]
+	if last.headerType&oggHeaderTypeEOS == 0 {
+		t.Fatalf("last page isn't marked as the end of the stream")
+	}
+	if rec.DurationMs() != 2400 {
+		t.Fatalf("expected a duration of 2400ms, got %d", rec.DurationMs())
+	}
+}
+
+func TestOggChecksumMismatch(t *testing.T) {
+	data := writeTestOgg(t, &Recording{ID: "test", Frames: [][]byte{testFrame(20, 1)}})
+	data[len(data)-1] ^= 0xff
+
+	if _, err := RecordingFromOgg("test", bytes.NewReader(data)); err == nil {
+		t.Fatalf("expected a corrupt page to be rejected")
+	}
+}
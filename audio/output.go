@@ -1,82 +1,228 @@
 package audio
 
 import (
+	"encoding/binary"
 	"fmt"
-	"github.com/hajimehoshi/oto"
-	"gopkg.in/hraban/opus.v2"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gen2brain/malgo"
+	"github.com/yusefnapora/party-line/types"
 )
 
+// how many buffers of mixed audio can be waiting for the device. With the mixer's 20ms ticks this is about
+// 200ms of audio, so the mixer blocks instead of racing ahead of playback.
+const outputQueueFrames = 10
+
+// if the device hasn't taken any audio in this long, e.g. because it was unplugged, PlayPCM gives up
+const outputWriteTimeout = time.Second
+
 type OutputDevice struct {
+	// the id from ListOutputDevices, or empty if this is the system default
+	deviceID string
+
 	sampleRate int
-	otoContext *oto.Context
-	player     *oto.Player
-	opusDec    *opus.Decoder
+	malgoCtx   *malgo.AllocatedContext
+	device     *malgo.Device
 
+	// decoded s16le pcm, waiting for the device to ask for it
 	pcmCh chan []byte
+	// closed by Close, to unblock PlayPCM
+	closed chan struct{}
+	// the rest of the last buffer taken from pcmCh. Only touched by the device's data callback.
+	pending []byte
+
+	gainLk     sync.Mutex
+	masterGain float64
+	muted      bool
 }
 
-func OpenOutputDevice(sampleRate int) (*OutputDevice, error) {
+// OpenOutputDevice opens the output device with the given id, as returned by ListOutputDevices.
+// If deviceID is empty, the system default device is used.
+func OpenOutputDevice(sampleRate int, deviceID string) (*OutputDevice, error) {
 	const numChannels = 1
-	otoCtx, err := oto.NewContext(sampleRate, numChannels, 2, 4096)
+
+	ctx, err := malgo.InitContext(nil, malgo.ContextConfig{}, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	opusDec, err := opus.NewDecoder(sampleRate, 1)
+	output := &OutputDevice{
+		deviceID:   deviceID,
+		sampleRate: sampleRate,
+		malgoCtx:   ctx,
+		pcmCh:      make(chan []byte, outputQueueFrames),
+		closed:     make(chan struct{}),
+		masterGain: 1,
+	}
+
+	cfg := malgo.DefaultDeviceConfig(malgo.Playback)
+	cfg.Playback.Format = malgo.FormatS16
+	cfg.Playback.Channels = numChannels
+	cfg.SampleRate = uint32(sampleRate)
+	if deviceID != "" {
+		id, err := findDevice(ctx, malgo.Playback, deviceID)
+		if err != nil {
+			output.closeContext()
+			return nil, err
+		}
+		cfg.Playback.DeviceID = id.Pointer()
+	}
+
+	output.device, err = malgo.InitDevice(ctx.Context, cfg, malgo.DeviceCallbacks{Data: output.fillBuffer})
 	if err != nil {
+		output.closeContext()
 		return nil, err
 	}
+	if err := output.device.Start(); err != nil {
+		output.device.Uninit()
+		output.closeContext()
+		return nil, err
+	}
+	return output, nil
+}
 
-	dev := OutputDevice{
-		sampleRate: sampleRate,
-		otoContext: otoCtx,
-		player:     otoCtx.NewPlayer(),
-		opusDec:    opusDec,
-		pcmCh:      make(chan []byte, 1000),
+func findDevice(ctx *malgo.AllocatedContext, kind malgo.DeviceType, deviceID string) (*malgo.DeviceID, error) {
+	devices, err := ctx.Devices(kind)
+	if err != nil {
+		return nil, err
 	}
-	return &dev, nil
+	for _, d := range devices {
+		if d.ID.String() == deviceID {
+			id := d.ID
+			return &id, nil
+		}
+	}
+	return nil, fmt.Errorf("no audio device with id %s", deviceID)
+}
+
+func (output *OutputDevice) closeContext() {
+	if err := output.malgoCtx.Uninit(); err != nil {
+		fmt.Printf("error closing audio context: %s\n", err)
+	}
+	output.malgoCtx.Free()
 }
 
 func (output *OutputDevice) Close() error {
-	if err := output.player.Close(); err != nil {
-		return err
+	close(output.closed)
+	output.device.Uninit()
+	output.closeContext()
+	return nil
+}
+
+// fillBuffer is called by malgo when the device needs more samples.
+func (output *OutputDevice) fillBuffer(out, _ []byte, _ uint32) {
+	for len(out) > 0 {
+		if len(output.pending) == 0 {
+			select {
+			case buf := <-output.pcmCh:
+				output.pending = buf
+			default:
+				// nothing to play, so fill the rest with silence
+				for i := range out {
+					out[i] = 0
+				}
+				return
+			}
+		}
+		n := copy(out, output.pending)
+		output.pending = output.pending[n:]
+		out = out[n:]
 	}
-	return output.otoContext.Close()
 }
 
-func (output *OutputDevice) PlayOpus(data []byte) error {
-	var frameSizeMs float32 = 20
-	frameSize := frameSizeMs * float32(output.sampleRate) / 1000
-	pcm := make([]int16, int(frameSize))
-	n, err := output.opusDec.Decode(data, pcm)
-	if err != nil {
-		return err
+// SetMasterGain scales the volume of everything played on this device. 1 is unchanged.
+func (output *OutputDevice) SetMasterGain(gain float64) {
+	output.gainLk.Lock()
+	defer output.gainLk.Unlock()
+	output.masterGain = gain
+}
+
+// SetMuted silences the device without changing the master gain.
+func (output *OutputDevice) SetMuted(muted bool) {
+	output.gainLk.Lock()
+	defer output.gainLk.Unlock()
+	output.muted = muted
+}
+
+func (output *OutputDevice) effectiveGain(gain float64) float64 {
+	output.gainLk.Lock()
+	defer output.gainLk.Unlock()
+	if output.muted {
+		return 0
 	}
+	return output.masterGain * gain
+}
+
+// PlayPCM queues 16-bit mono samples for playback, scaled by gain and the master gain.
+// It blocks while the device's queue is full, and returns an error if the device is closed
+// or stops taking audio.
+func (output *OutputDevice) PlayPCM(pcm []int16, gain float64) error {
+	// copied, since the caller may reuse pcm and the gain is applied in place
+	pcm = append([]int16(nil), pcm...)
+	applyGain(pcm, output.effectiveGain(gain))
 
-	buf := make([]byte, n*2)
-	for i := 0; i < n; i++ {
-		sample := pcm[i]
-		buf[i*2] = byte(sample)
-		buf[(i*2)+1] = byte(sample >> 8)
+	buf := make([]byte, len(pcm)*2)
+	for i, sample := range pcm {
+		binary.LittleEndian.PutUint16(buf[i*2:], uint16(sample))
 	}
 
-	var written = 0
-	for written < n*2 {
-		written, err = output.player.Write(buf)
-		if err != nil {
-			return err
+	timer := time.NewTimer(outputWriteTimeout)
+	defer timer.Stop()
+	select {
+	case output.pcmCh <- buf:
+		return nil
+	case <-output.closed:
+		return fmt.Errorf("output device is closed")
+	case <-timer.C:
+		return fmt.Errorf("output device isn't taking audio")
+	}
+}
+
+// applyGain scales pcm in place, clipping samples that would overflow.
+func applyGain(pcm []int16, gain float64) {
+	if gain == 1 {
+		return
+	}
+	for i, s := range pcm {
+		v := float64(s) * gain
+		if v > math.MaxInt16 {
+			v = math.MaxInt16
+		} else if v < math.MinInt16 {
+			v = math.MinInt16
 		}
-		buf = buf[written:]
+		pcm[i] = int16(v)
 	}
-	return err
 }
 
-func (output *OutputDevice) PlayRecording(rec *Recording) error {
-	fmt.Printf("playing recording %s\n", rec.ID)
-	for _, frame := range rec.Frames {
-		if err := output.PlayOpus(frame); err != nil {
-			return err
+func ListOutputDevices() ([]*types.OutputDeviceInfo, error) {
+	ctx, err := malgo.InitContext(nil, malgo.ContextConfig{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = ctx.Uninit()
+		ctx.Free()
+	}()
+
+	devices, err := ctx.Devices(malgo.Playback)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []*types.OutputDeviceInfo
+	for _, device := range devices {
+		info, err := ctx.DeviceInfo(malgo.Playback, device.ID, malgo.Shared)
+		if err != nil {
+			continue
 		}
+		infos = append(infos, &types.OutputDeviceInfo{
+			DeviceId:  device.ID.String(),
+			Name:      strings.TrimRight(info.Name(), "\u0000"), // names are trailed by null byte padding
+			IsDefault: info.IsDefault != 0,
+		})
 	}
-	return nil
+	return infos, nil
 }
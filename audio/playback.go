@@ -0,0 +1,310 @@
+package audio
+
+import (
+	"fmt"
+	"sync"
+)
+
+// PlaybackListener is told about the progress of recordings played by a Player.
+// Its methods are called from the Player's goroutine, so they shouldn't block for long.
+type PlaybackListener interface {
+	PlaybackStarted(recordingID string, totalFrames int, durationMs int64)
+	PlaybackProgress(recordingID string, frameIndex int, totalFrames int, paused bool)
+	PlaybackFinished(recordingID string, stopped bool)
+}
+
+// how often to report progress while a recording plays. With 20ms frames this is every 200ms.
+const playbackProgressInterval = 10
+
+type playbackItem struct {
+	recordingID string
+	gain        float64
+
+	// these are guarded by the Player's lock
+	totalFrames int
+	pos         int
+	seekTo      int // -1 if there's no seek waiting
+	stopped     bool
+}
+
+// Player plays recordings from a Store one at a time. They're played through the Store's mixer,
+// so they can still be heard alongside live streams.
+//
+//
+// - Play queues a recording behind the one that's playing and any others already queued,
+//   unless interrupt is set, in which case the current recording is stopped and the queue is cleared first.
+// - Playing a recording that's already queued does nothing. Playing the current recording resumes it if it's
+//   paused, and otherwise does nothing.
+// - Pause, Resume and Seek only apply to the current recording. Stop also removes a recording from the queue.
+type Player struct {
+	store    *Store
+	listener PlaybackListener
+
+	lk      sync.Mutex
+	cond    *sync.Cond
+	queue   []*playbackItem
+	current *playbackItem
+	paused  bool
+}
+
+func NewPlayer(store *Store, listener PlaybackListener) *Player {
+	p := &Player{
+		store:    store,
+		listener: listener,
+	}
+	p.cond = sync.NewCond(&p.lk)
+	go p.run()
+	return p
+}
+
+// Play queues the recording with the given id, with its volume scaled by gain on top of the master gain.
+// If interrupt is true, whatever's playing is stopped and the queue is cleared first.
+func (p *Player) Play(recordingID string, gain float64, interrupt bool) error {
+	if !p.store.hasRecording(recordingID) {
+		return fmt.Errorf("no recording found with id %s", recordingID)
+	}
+
+	p.lk.Lock()
+	var stopped []string
+	if interrupt {
+		stopped = p.stopAll()
+	} else if item := p.current; item != nil && item.recordingID == recordingID && !item.stopped {
+		resumed := p.paused
+		p.paused = false
+		p.cond.Broadcast()
+		pos, total := item.pos, item.totalFrames
+		p.lk.Unlock()
+		if resumed {
+			p.listener.PlaybackProgress(recordingID, pos, total, false)
+		}
+		return nil
+	} else if p.queued(recordingID) {
+		p.lk.Unlock()
+		return nil
+	}
+
+	p.queue = append(p.queue, &playbackItem{recordingID: recordingID, gain: gain, seekTo: -1})
+	p.cond.Broadcast()
+	p.lk.Unlock()
+
+	p.notifyRemoved(stopped)
+	return nil
+}
+
+// Pause pauses the current recording. If recordingID isn't empty, it must be the current recording.
+func (p *Player) Pause(recordingID string) error {
+	return p.setPaused(recordingID, true)
+}
+
+// Resume continues the current recording after Pause. If recordingID isn't empty, it must be the current recording.
+func (p *Player) Resume(recordingID string) error {
+	return p.setPaused(recordingID, false)
+}
+
+func (p *Player) setPaused(recordingID string, paused bool) error {
+	p.lk.Lock()
+	item, err := p.currentItem(recordingID)
+	if err != nil {
+		p.lk.Unlock()
+		return err
+	}
+	changed := p.paused != paused
+	p.paused = paused
+	p.cond.Broadcast()
+	pos, total := item.pos, item.totalFrames
+	p.lk.Unlock()
+
+	if changed {
+		p.listener.PlaybackProgress(item.recordingID, pos, total, paused)
+	}
+	return nil
+}
+
+// Seek moves the current recording to the given frame. If recordingID isn't empty, it must be the current recording.
+// Seeking to the end finishes the recording.
+func (p *Player) Seek(recordingID string, frameIndex int) error {
+	if frameIndex < 0 {
+		return fmt.Errorf("invalid frame index %d", frameIndex)
+	}
+
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	item, err := p.currentItem(recordingID)
+	if err != nil {
+		return err
+	}
+	if item.totalFrames > 0 && frameIndex > item.totalFrames {
+		frameIndex = item.totalFrames
+	}
+	item.seekTo = frameIndex
+	p.cond.Broadcast()
+	return nil
+}
+
+// Stop stops the recording with the given id, or removes it from the queue.
+// If recordingID is empty, the current recording is stopped and the queue is cleared.
+func (p *Player) Stop(recordingID string) error {
+	p.lk.Lock()
+	if recordingID == "" {
+		removed := p.stopAll()
+		p.lk.Unlock()
+		p.notifyRemoved(removed)
+		return nil
+	}
+
+	if p.current != nil && p.current.recordingID == recordingID && !p.current.stopped {
+		p.current.stopped = true
+		p.cond.Broadcast()
+		p.lk.Unlock()
+		return nil
+	}
+	for i, item := range p.queue {
+		if item.recordingID == recordingID {
+			p.queue = append(p.queue[:i], p.queue[i+1:]...)
+			p.lk.Unlock()
+			p.notifyRemoved([]string{recordingID})
+			return nil
+		}
+	}
+	p.lk.Unlock()
+	return fmt.Errorf("recording %s isn't playing", recordingID)
+}
+
+// stopAll stops the current recording and clears the queue. It returns the ids of the recordings that were
+// removed from the queue, which the caller should report with notifyRemoved once the lock is released.
+// Must be called with the lock held.
+func (p *Player) stopAll() []string {
+	if p.current != nil {
+		p.current.stopped = true
+	}
+	var removed []string
+	for _, item := range p.queue {
+		removed = append(removed, item.recordingID)
+	}
+	p.queue = nil
+	p.cond.Broadcast()
+	return removed
+}
+
+// notifyRemoved reports recordings that were taken out of the queue before they started.
+func (p *Player) notifyRemoved(ids []string) {
+	for _, id := range ids {
+		p.listener.PlaybackFinished(id, true)
+	}
+}
+
+// currentItem must be called with the lock held.
+func (p *Player) currentItem(recordingID string) (*playbackItem, error) {
+	if p.current == nil || p.current.stopped {
+		return nil, fmt.Errorf("nothing is playing")
+	}
+	if recordingID != "" && p.current.recordingID != recordingID {
+		return nil, fmt.Errorf("recording %s isn't playing", recordingID)
+	}
+	return p.current, nil
+}
+
+// queued must be called with the lock held.
+func (p *Player) queued(recordingID string) bool {
+	for _, item := range p.queue {
+		if item.recordingID == recordingID {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Player) run() {
+	for {
+		p.lk.Lock()
+		for len(p.queue) == 0 {
+			p.cond.Wait()
+		}
+		item := p.queue[0]
+		p.queue = p.queue[1:]
+		p.current = item
+		p.paused = false
+		p.lk.Unlock()
+
+		p.play(item)
+
+		p.lk.Lock()
+		p.current = nil
+		p.lk.Unlock()
+	}
+}
+
+func (p *Player) play(item *playbackItem) {
+	rec, ok := p.store.GetRecording(item.recordingID)
+	if !ok {
+		fmt.Printf("recording %s was removed before it could be played\n", item.recordingID)
+		p.listener.PlaybackFinished(item.recordingID, true)
+		return
+	}
+
+	src, err := p.store.openSource(item.gain, rec.FrameSizeMs)
+	if err != nil {
+		fmt.Printf("error playing recording %s: %s\n", rec.ID, err)
+		p.listener.PlaybackFinished(rec.ID, true)
+		return
+	}
+	defer src.Close()
+
+	total := len(rec.Frames)
+	p.lk.Lock()
+	item.totalFrames = total
+	p.lk.Unlock()
+
+	fmt.Printf("playing recording %s\n", rec.ID)
+	p.listener.PlaybackStarted(rec.ID, total, rec.DurationMs())
+
+	for {
+		p.lk.Lock()
+		for p.paused && !item.stopped && item.seekTo < 0 {
+			p.cond.Wait()
+		}
+		if item.stopped {
+			p.lk.Unlock()
+			src.Flush()
+			p.listener.PlaybackFinished(rec.ID, true)
+			return
+		}
+		seeked := item.seekTo >= 0
+		if seeked {
+			item.pos = item.seekTo
+			item.seekTo = -1
+		}
+		pos, paused := item.pos, p.paused
+		p.lk.Unlock()
+
+		if seeked {
+			// drop whatever was queued from before the seek, so it takes effect right away
+			src.Flush()
+			p.listener.PlaybackProgress(rec.ID, pos, total, paused)
+			if paused {
+				continue
+			}
+		}
+		if pos >= total {
+			break
+		}
+
+		if err := src.WriteOpus(rec.Frames[pos]); err != nil {
+			fmt.Printf("error playing recording %s: %s\n", rec.ID, err)
+			p.listener.PlaybackFinished(rec.ID, true)
+			return
+		}
+
+		p.lk.Lock()
+		// a seek while the frame was playing has already set a new position
+		if item.seekTo < 0 {
+			item.pos = pos + 1
+		}
+		p.lk.Unlock()
+
+		if (pos+1)%playbackProgressInterval == 0 {
+			p.listener.PlaybackProgress(rec.ID, pos+1, total, false)
+		}
+	}
+	p.listener.PlaybackFinished(rec.ID, false)
+}
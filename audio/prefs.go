@@ -0,0 +1,130 @@
+package audio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// DefaultDevicePrefsPath returns the location of the audio device settings inside the given data directory.
+func DefaultDevicePrefsPath(dataDir string) string {
+	return filepath.Join(dataDir, "audio-devices.json")
+}
+
+// DevicePrefs remembers which audio devices the user picked, so the choice survives restarts.
+type DevicePrefs struct {
+	lk   sync.Mutex
+	path string
+
+	prefs devicePrefsFile
+}
+
+type devicePrefsFile struct {
+	InputDeviceID  string `json:"input_device_id"`
+	OutputDeviceID string `json:"output_device_id"`
+
+	// nil means the default gain of 1, since a stored zero would be silent
+	MasterGain *float64 `json:"master_gain,omitempty"`
+	Muted      bool     `json:"muted"`
+}
+
+// LoadDevicePrefs reads the device settings at path. A missing file just means nothing has been picked yet.
+// If path is empty, the settings are only kept in memory.
+func LoadDevicePrefs(path string) (*DevicePrefs, error) {
+	p := &DevicePrefs{path: path}
+	if path == "" {
+		return p, nil
+	}
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return p, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(buf, &p.prefs); err != nil {
+		return nil, fmt.Errorf("error parsing audio device settings %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// InputDeviceID returns the id of the chosen input device, or the empty string to use the system default.
+func (p *DevicePrefs) InputDeviceID() string {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	return p.prefs.InputDeviceID
+}
+
+func (p *DevicePrefs) SetInputDeviceID(id string) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	p.prefs.InputDeviceID = id
+	return p.save()
+}
+
+// OutputDeviceID returns the id of the chosen output device, or the empty string to use the system default.
+func (p *DevicePrefs) OutputDeviceID() string {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	return p.prefs.OutputDeviceID
+}
+
+func (p *DevicePrefs) SetOutputDeviceID(id string) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	p.prefs.OutputDeviceID = id
+	return p.save()
+}
+
+// MasterGain returns the volume scale for all playback. 1 is unchanged.
+func (p *DevicePrefs) MasterGain() float64 {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	if p.prefs.MasterGain == nil {
+		return 1
+	}
+	return *p.prefs.MasterGain
+}
+
+func (p *DevicePrefs) SetMasterGain(gain float64) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	p.prefs.MasterGain = &gain
+	return p.save()
+}
+
+func (p *DevicePrefs) Muted() bool {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	return p.prefs.Muted
+}
+
+func (p *DevicePrefs) SetMuted(muted bool) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	p.prefs.Muted = muted
+	return p.save()
+}
+
+// save must be called with the lock held.
+func (p *DevicePrefs) save() error {
+	if p.path == "" {
+		return nil
+	}
+	buf, err := json.MarshalIndent(&p.prefs, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p.path), 0700); err != nil {
+		return err
+	}
+	tmp := p.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p.path)
+}
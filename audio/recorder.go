@@ -3,14 +3,20 @@ package audio
 import (
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/tevino/abool"
+	"github.com/yusefnapora/party-line/types"
 )
 
 type Recording struct {
 	ID     string
 	Frames [][]byte
+
+	// the length of each frame, if it's known. Recordings from older versions and other apps may not say,
+	// and imported ones may mix frame sizes.
+	FrameSizeMs int `json:",omitempty"`
 }
 
 func (r *Recording) ToJSON() ([]byte, error) {
@@ -28,28 +34,136 @@ func RecordingFromJSON(b []byte) (*Recording, error) {
 
 type Recorder struct {
 	recording abool.AtomicBool
-	stopCh    chan struct{}
 
+	// sessionLk guards session, the recording in progress or the last one
+	sessionLk sync.Mutex
+	session   *recordingSession
+
+	// deviceLk guards inputDevice, which can be swapped out by SelectInputDevice
+	deviceLk    sync.Mutex
 	inputDevice *InputDevice
+	prefs       *DevicePrefs
+
+	store   *Store
+	profile CodecProfile
 
-	store *Store
+	// frames of push-to-talk recordings are sent here, to be streamed to peers
+	voiceCh chan<- *types.VoiceFrame
+
+	// recordings are always stopped after this long. Zero means no limit.
+	maxDuration time.Duration
 }
 
-const sampleRate = 48000
+// recordingSession lets a recording be stopped by the user or by its time limit, whichever comes first.
+type recordingSession struct {
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	timer    *time.Timer
+}
 
-func NewRecorder(store *Store) (*Recorder, error) {
-	inputDevice, err := OpenInputDevice(sampleRate)
+// stop returns true if this call stopped the recording, or false if it was already stopped.
+func (s *recordingSession) stop() bool {
+	stopped := false
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+		if s.timer != nil {
+			s.timer.Stop()
+		}
+		stopped = true
+	})
+	return stopped
+}
+
+// NewRecorder returns a Recorder that saves to store, using the input device chosen in prefs and encoding
+// with the given codec profile. Push-to-talk frames are sent to voiceCh as they're recorded.
+// maxDuration caps the length of every recording, even if the caller asks for a longer one. Zero means no cap.
+func NewRecorder(store *Store, prefs *DevicePrefs, voiceCh chan<- *types.VoiceFrame, profile CodecProfile, maxDuration time.Duration) (*Recorder, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid codec profile: %w", err)
+	}
+	fmt.Printf("recording with codec profile: %s\n", profile)
+
+	deviceID := prefs.InputDeviceID()
+	inputDevice, err := OpenInputDevice(profile, deviceID)
+	if err != nil && deviceID != "" {
+		fmt.Printf("error opening audio input %s, trying the default device. error: %s\n", deviceID, err)
+		inputDevice, err = OpenInputDevice(profile, "")
+	}
 	if err != nil {
 		fmt.Printf("error initializing audio input. recording will be disabled. error: %s\n", err)
 	}
 
 	return &Recorder{
 		inputDevice: inputDevice,
+		prefs:       prefs,
 		store:       store,
+		profile:     profile,
+		voiceCh:     voiceCh,
+		maxDuration: maxDuration,
 	}, nil
 }
 
-func (r *Recorder) BeginRecording(maxDuration time.Duration) (string, error) {
+// InputDeviceID returns the id of the input device we're recording from, or the empty string
+// if it's the system default or there's no input device.
+func (r *Recorder) InputDeviceID() string {
+	r.deviceLk.Lock()
+	defer r.deviceLk.Unlock()
+	if r.inputDevice == nil {
+		return ""
+	}
+	return r.inputDevice.deviceID
+}
+
+// SelectInputDevice switches to the input device with the given id, as returned by ListInputDevices,
+// and remembers the choice for next time. An empty id selects the system default device.
+// It fails if a recording is in progress.
+func (r *Recorder) SelectInputDevice(deviceID string) error {
+	r.deviceLk.Lock()
+	defer r.deviceLk.Unlock()
+
+	if r.recording.IsSet() {
+		return fmt.Errorf("can't change input device while recording")
+	}
+
+	dev, err := OpenInputDevice(r.profile, deviceID)
+	if err != nil {
+		return fmt.Errorf("error opening audio input: %w", err)
+	}
+	if r.inputDevice != nil {
+		if err := r.inputDevice.Close(); err != nil {
+			fmt.Printf("error closing audio input: %s\n", err)
+		}
+	}
+	r.inputDevice = dev
+	fmt.Printf("switched audio input to %q\n", deviceID)
+
+	if err := r.prefs.SetInputDeviceID(deviceID); err != nil {
+		fmt.Printf("error saving audio input choice: %s\n", err)
+	}
+	return nil
+}
+
+// BeginRecording starts recording from the input device, and returns the new recording's id.
+// If the recording is still going after maxDuration (or the recorder's own limit, if that's shorter),
+// it's stopped automatically and onMaxDuration is called with the recording's id.
+func (r *Recorder) BeginRecording(maxDuration time.Duration, onMaxDuration func(recordingID string)) (string, error) {
+	return r.begin(maxDuration, onMaxDuration, false, true)
+}
+
+// BeginTalking starts a push-to-talk recording, whose frames are streamed to peers as they're recorded.
+// It's stopped with StopRecording, like any other recording. If keep is true, the recording is saved to the
+// store afterwards, so it can also be sent as an attachment; otherwise it's thrown away.
+func (r *Recorder) BeginTalking(maxDuration time.Duration, onMaxDuration func(recordingID string), keep bool) (string, error) {
+	if r.voiceCh == nil {
+		return "", fmt.Errorf("live voice is not enabled")
+	}
+	return r.begin(maxDuration, onMaxDuration, true, keep)
+}
+
+func (r *Recorder) begin(maxDuration time.Duration, onMaxDuration func(recordingID string), live bool, keep bool) (string, error) {
+	r.deviceLk.Lock()
+	defer r.deviceLk.Unlock()
+
 	if r.inputDevice == nil {
 		return "", fmt.Errorf("no audio input device")
 	}
@@ -59,33 +173,89 @@ func (r *Recorder) BeginRecording(maxDuration time.Duration) (string, error) {
 	}
 	r.recording.Set()
 
-	rec := r.store.NewLocalRecording()
-	r.stopCh = make(chan struct{})
+	if r.maxDuration > 0 && (maxDuration <= 0 || maxDuration > r.maxDuration) {
+		maxDuration = r.maxDuration
+	}
 
-	if maxDuration != 0 {
-		time.AfterFunc(maxDuration, func() {
-			r.stopCh <- struct{}{}
+	rec := r.store.NewLocalRecording()
+	rec.FrameSizeMs = r.profile.FrameSizeMs
+	session := &recordingSession{stopCh: make(chan struct{})}
+	if maxDuration > 0 {
+		session.timer = time.AfterFunc(maxDuration, func() {
+			if session.stop() {
+				fmt.Printf("recording %s reached its max duration of %s\n", rec.ID, maxDuration)
+				if onMaxDuration != nil {
+					onMaxDuration(rec.ID)
+				}
+			}
 		})
 	}
+	r.sessionLk.Lock()
+	r.session = session
+	r.sessionLk.Unlock()
 
-	go r.doRecording(rec)
+	go r.doRecording(r.inputDevice, rec, session.stopCh, live, keep)
 	return rec.ID, nil
 }
 
-func (r *Recorder) doRecording(rec *Recording) {
+func (r *Recorder) doRecording(input *InputDevice, rec *Recording, stopCh <-chan struct{}, live bool, keep bool) {
 
-	frameCh := r.inputDevice.ReadOpus(r.stopCh)
+	var seq uint32
+	start := time.Now()
+	frameCh := input.ReadOpus(stopCh)
 	for frame := range frameCh {
-		rec.Frames = append(rec.Frames, frame)
+		if keep {
+			rec.Frames = append(rec.Frames, frame)
+		}
+		if live {
+			r.sendVoice(&types.VoiceFrame{
+				TalkId:      rec.ID,
+				Seq:         seq,
+				OpusFrame:   frame,
+				TimestampMs: uint32(time.Since(start) / time.Millisecond),
+			})
+			seq++
+		}
+	}
+	if live {
+		// this one should get through, or peers would wait for frames that aren't coming,
+		// but never at the cost of wedging the recorder
+		select {
+		case r.voiceCh <- &types.VoiceFrame{TalkId: rec.ID, Seq: seq, End: true}:
+		case <-time.After(voiceEndTimeout):
+			fmt.Printf("timed out sending the end of talk %s\n", rec.ID)
+		}
+	}
+
+	if keep {
+		// now that it's complete, the store can save it to disk
+		r.store.AddRecording(rec)
+	} else {
+		r.store.discardRecording(rec.ID)
 	}
 
 	r.recording.UnSet()
 }
 
+// how long to wait for room to send the end of a talk
+const voiceEndTimeout = 5 * time.Second
+
+// sendVoice drops frames if the network can't keep up, since late audio is no use to anyone.
+func (r *Recorder) sendVoice(f *types.VoiceFrame) {
+	select {
+	case r.voiceCh <- f:
+	default:
+		fmt.Printf("dropping live voice frame %d\n", f.Seq)
+	}
+}
+
 func (r *Recorder) StopRecording() error {
-	if r.recording.IsNotSet() {
+	r.sessionLk.Lock()
+	session := r.session
+	r.sessionLk.Unlock()
+
+	if r.recording.IsNotSet() || session == nil || !session.stop() {
 		return fmt.Errorf("no recording in progress")
 	}
-	r.stopCh <- struct{}{}
 	return nil
 }
@@ -3,28 +3,136 @@ package audio
 import (
 	"fmt"
 	"github.com/google/uuid"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
+	"time"
+
+	"github.com/yusefnapora/party-line/types"
 )
 
+const recordingFileExt = ".json"
+
+// everything is decoded and played at this rate, whatever rate it was recorded at
+const sampleRate = 48000
+
+// DefaultRecordingsDir returns the location of saved recordings inside the given data directory.
+func DefaultRecordingsDir(dataDir string) string {
+	return filepath.Join(dataDir, "recordings")
+}
+
+type StoreConfig struct {
+	// Dir is where recordings are saved. If empty, recordings are only kept in memory.
+	Dir string
+
+	// MaxBytes is how much disk space saved recordings may use before the least recently
+	// used ones are deleted. Zero means no limit.
+	MaxBytes int64
+
+	// MaxRecordings is how many recordings are kept on disk before the least recently
+	// used ones are deleted. Zero means no limit.
+	MaxRecordings int
+
+	// DevicePrefs has the output device and volume to play recordings with. If nil, the
+	// system default device is used at full volume, and changes aren't saved.
+	DevicePrefs *DevicePrefs
+}
+
+// storedRecording is what we know about a recording that's been saved to disk.
+type storedRecording struct {
+	size     int64
+	lastUsed time.Time
+}
+
 type Store struct {
 	sync.RWMutex
 
+	cfg StoreConfig
+
+	// recordings that only live in memory: ones that are still being recorded, or
+	// all of them if there's no storage dir
 	recordings map[string]*Recording
 
+	// recordings saved in cfg.Dir. Their frames are read from disk when needed.
+	saved     map[string]*storedRecording
+	savedSize int64
+
+	// outputLk guards outputDevice. Playback only holds it long enough to look the device up, so a device
+	// that stops taking audio can't keep SelectOutputDevice from switching to another one.
+	outputLk     sync.RWMutex
 	outputDevice *OutputDevice
+	prefs        *DevicePrefs
+
+	// everything is played through the mixer, so recordings and live streams can be heard at the same time
+	mixer *Mixer
+
+	liveLk      sync.Mutex
+	liveStreams map[*LiveStream]struct{}
+	// volume of live audio from each peer, if it's been changed from 1
+	peerGains map[string]float64
+	// stats of the live streams that have ended most recently
+	endedLiveStats []*types.LiveStreamStats
 }
 
-func NewStore() (*Store, error) {
-	// TODO: should probably open the device elsewhere and inject here
-	outputDevice, err := OpenOutputDevice(sampleRate)
+func NewStore(cfg StoreConfig) (*Store, error) {
+	prefs := cfg.DevicePrefs
+	if prefs == nil {
+		prefs, _ = LoadDevicePrefs("")
+	}
+
+	deviceID := prefs.OutputDeviceID()
+	outputDevice, err := OpenOutputDevice(sampleRate, deviceID)
+	if err != nil && deviceID != "" {
+		fmt.Printf("error opening output device %s, trying the default device. error: %s\n", deviceID, err)
+		outputDevice, err = OpenOutputDevice(sampleRate, "")
+	}
 	if err != nil {
 		fmt.Printf("error initializing output device - audio playback will be disabled. error: %s\n", err)
+	} else {
+		outputDevice.SetMasterGain(prefs.MasterGain())
+		outputDevice.SetMuted(prefs.Muted())
 	}
 
-	return &Store{
+	s := &Store{
+		cfg:          cfg,
 		recordings:   make(map[string]*Recording),
+		saved:        make(map[string]*storedRecording),
 		outputDevice: outputDevice,
-	}, nil
+		prefs:        prefs,
+		liveStreams:  make(map[*LiveStream]struct{}),
+		peerGains:    make(map[string]float64),
+	}
+	s.mixer = NewMixer(sampleRate, s.playMixed)
+	if cfg.Dir == "" {
+		return s, nil
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0700); err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), recordingFileExt) {
+			continue
+		}
+		id := strings.TrimSuffix(f.Name(), recordingFileExt)
+		s.saved[id] = &storedRecording{size: f.Size(), lastUsed: f.ModTime()}
+		s.savedSize += f.Size()
+	}
+	fmt.Printf("found %d saved recordings (%d bytes) in %s\n", len(s.saved), s.savedSize, cfg.Dir)
+
+	s.Lock()
+	s.evict()
+	s.Unlock()
+	return s, nil
 }
 
 func (s *Store) NewLocalRecording() *Recording {
@@ -39,27 +147,260 @@ func (s *Store) NewLocalRecording() *Recording {
 	return rec
 }
 
+// AddRecording saves a complete recording. If the store has a storage dir, the frames are
+// written to disk and dropped from memory.
 func (s *Store) AddRecording(rec *Recording) {
 	s.Lock()
 	defer s.Unlock()
+	s.addRecording(rec)
+}
+
+// ImportRecording saves a recording that someone else sent us, like AddRecording, unless we already have one
+// with the same id. Attachment ids are chosen by the sender, so otherwise anyone could replace a recording
+// by sending another one with its id.
+func (s *Store) ImportRecording(rec *Recording) error {
+	s.Lock()
+	defer s.Unlock()
+
+	_, inMemory := s.recordings[rec.ID]
+	_, saved := s.saved[rec.ID]
+	if inMemory || saved {
+		return fmt.Errorf("already have a recording with id %s", rec.ID)
+	}
+	s.addRecording(rec)
+	return nil
+}
 
-	s.recordings[rec.ID] = rec
+// addRecording must be called with the lock held.
+func (s *Store) addRecording(rec *Recording) {
+	if s.cfg.Dir == "" {
+		s.recordings[rec.ID] = rec
+		return
+	}
+
+	if err := s.save(rec); err != nil {
+		fmt.Printf("error saving recording %s, keeping it in memory: %s\n", rec.ID, err)
+		s.recordings[rec.ID] = rec
+		return
+	}
+	delete(s.recordings, rec.ID)
+	s.evict()
+}
+
+// discardRecording forgets a local recording that won't be saved, like a push-to-talk stream.
+func (s *Store) discardRecording(id string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.recordings, id)
+}
+
+// ImportOgg reads an Ogg Opus file into a new recording and saves it.
+func (s *Store) ImportOgg(r io.Reader) (*Recording, error) {
+	rec, err := RecordingFromOgg(uuid.New().String(), r)
+	if err != nil {
+		return nil, err
+	}
+	if len(rec.Frames) == 0 {
+		return nil, fmt.Errorf("ogg file has no audio")
+	}
+	s.AddRecording(rec)
+	fmt.Printf("imported recording %s (%d ms)\n", rec.ID, rec.DurationMs())
+	return rec, nil
+}
+
+// openSource adds a new source to the mixer, for one recording or live stream to play through.
+func (s *Store) openSource(gain float64, frameSizeMs int) (*MixerSource, error) {
+	s.outputLk.RLock()
+	hasOutput := s.outputDevice != nil
+	s.outputLk.RUnlock()
+	if !hasOutput {
+		return nil, fmt.Errorf("no output device")
+	}
+	return s.mixer.AddSource(gain, frameSizeMs)
+}
+
+// playMixed sends the mixer's output to the output device.
+func (s *Store) playMixed(pcm []int16) {
+	s.outputLk.RLock()
+	dev := s.outputDevice
+	s.outputLk.RUnlock()
+
+	if dev == nil {
+		return
+	}
+	// if the device is switched or closed meanwhile, this tick is dropped and the next one goes to the new device
+	if err := dev.PlayPCM(pcm, 1); err != nil {
+		fmt.Printf("error playing audio: %s\n", err)
+	}
 }
 
-func (s *Store) PlayRecording(id string) error {
+// OutputDeviceID returns the id of the device recordings are played on, or the empty string
+// if it's the system default or there's no output device.
+func (s *Store) OutputDeviceID() string {
+	s.outputLk.RLock()
+	defer s.outputLk.RUnlock()
 	if s.outputDevice == nil {
-		fmt.Printf("asked to play recording %s, but no output device.\n", id)
+		return ""
 	}
-	rec, ok := s.GetRecording(id)
-	if !ok {
-		return fmt.Errorf("no recording found with id %s", id)
+	return s.outputDevice.deviceID
+}
+
+// SelectOutputDevice switches playback to the output device with the given id, as returned by ListOutputDevices,
+// and remembers the choice for next time. An empty id selects the system default device.
+// Anything that's playing carries on from the next tick on the new device.
+func (s *Store) SelectOutputDevice(deviceID string) error {
+	s.outputLk.Lock()
+	defer s.outputLk.Unlock()
+
+	dev, err := OpenOutputDevice(sampleRate, deviceID)
+	if err != nil {
+		return fmt.Errorf("error opening output device: %w", err)
+	}
+	dev.SetMasterGain(s.prefs.MasterGain())
+	dev.SetMuted(s.prefs.Muted())
+
+	if s.outputDevice != nil {
+		if err := s.outputDevice.Close(); err != nil {
+			fmt.Printf("error closing output device: %s\n", err)
+		}
 	}
-	return s.outputDevice.PlayRecording(rec)
+	s.outputDevice = dev
+	fmt.Printf("switched audio output to %q\n", deviceID)
+
+	if err := s.prefs.SetOutputDeviceID(deviceID); err != nil {
+		fmt.Printf("error saving audio output choice: %s\n", err)
+	}
+	return nil
 }
 
-func (s *Store) GetRecording(id string) (*Recording, bool) {
+// PlaybackSettings returns the current master gain and mute state.
+func (s *Store) PlaybackSettings() (masterGain float64, muted bool) {
+	return s.prefs.MasterGain(), s.prefs.Muted()
+}
+
+// SetMasterGain scales the volume of all playback. 1 is unchanged; it must not be negative.
+func (s *Store) SetMasterGain(gain float64) error {
+	if gain < 0 || math.IsNaN(gain) || math.IsInf(gain, 0) {
+		return fmt.Errorf("invalid gain %v", gain)
+	}
+	// not the write lock, so this takes effect while a recording is playing
+	s.outputLk.RLock()
+	if s.outputDevice != nil {
+		s.outputDevice.SetMasterGain(gain)
+	}
+	s.outputLk.RUnlock()
+	return s.prefs.SetMasterGain(gain)
+}
+
+func (s *Store) SetMuted(muted bool) error {
+	s.outputLk.RLock()
+	if s.outputDevice != nil {
+		s.outputDevice.SetMuted(muted)
+	}
+	s.outputLk.RUnlock()
+	return s.prefs.SetMuted(muted)
+}
+
+func (s *Store) hasRecording(id string) bool {
 	s.RLock()
 	defer s.RUnlock()
-	rec, ok := s.recordings[id]
-	return rec, ok
+	_, inMemory := s.recordings[id]
+	_, saved := s.saved[id]
+	return inMemory || saved
+}
+
+// GetRecording returns the recording with the given id, reading it from disk if needed.
+func (s *Store) GetRecording(id string) (*Recording, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	if rec, ok := s.recordings[id]; ok {
+		return rec, true
+	}
+
+	info, ok := s.saved[id]
+	if !ok {
+		return nil, false
+	}
+	rec, err := s.load(id)
+	if err != nil {
+		fmt.Printf("error loading recording %s: %s\n", id, err)
+		return nil, false
+	}
+	info.lastUsed = time.Now()
+	return rec, true
+}
+
+func (s *Store) recordingPath(id string) string {
+	return filepath.Join(s.cfg.Dir, id+recordingFileExt)
+}
+
+// save must be called with the lock held.
+func (s *Store) save(rec *Recording) error {
+	// ids come from other peers, so make sure they can't point outside the storage dir
+	if rec.ID == "" || strings.ContainsAny(rec.ID, `/\`) || strings.HasPrefix(rec.ID, ".") {
+		return fmt.Errorf("invalid recording id %q", rec.ID)
+	}
+
+	buf, err := rec.ToJSON()
+	if err != nil {
+		return err
+	}
+	path := s.recordingPath(rec.ID)
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+
+	if old, ok := s.saved[rec.ID]; ok {
+		s.savedSize -= old.size
+	}
+	s.saved[rec.ID] = &storedRecording{size: int64(len(buf)), lastUsed: time.Now()}
+	s.savedSize += int64(len(buf))
+	return nil
+}
+
+// load must be called with the lock held.
+func (s *Store) load(id string) (*Recording, error) {
+	buf, err := ioutil.ReadFile(s.recordingPath(id))
+	if err != nil {
+		return nil, err
+	}
+	return RecordingFromJSON(buf)
+}
+
+// evict deletes the least recently used recordings until we're within the configured limits.
+// Must be called with the lock held.
+func (s *Store) evict() {
+	overLimit := func() bool {
+		return (s.cfg.MaxBytes > 0 && s.savedSize > s.cfg.MaxBytes) ||
+			(s.cfg.MaxRecordings > 0 && len(s.saved) > s.cfg.MaxRecordings)
+	}
+	if !overLimit() {
+		return
+	}
+
+	ids := make([]string, 0, len(s.saved))
+	for id := range s.saved {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return s.saved[ids[i]].lastUsed.Before(s.saved[ids[j]].lastUsed)
+	})
+
+	for _, id := range ids {
+		if !overLimit() {
+			return
+		}
+		fmt.Printf("removing recording %s to stay within the storage limits\n", id)
+		if err := os.Remove(s.recordingPath(id)); err != nil && !os.IsNotExist(err) {
+			fmt.Printf("error removing recording %s: %s\n", id, err)
+			continue
+		}
+		s.savedSize -= s.saved[id].size
+		delete(s.saved, id)
+	}
 }
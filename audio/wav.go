@@ -0,0 +1,72 @@
+package audio
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"gopkg.in/hraban/opus.v2"
+)
+
+const (
+	wavChannels      = 1
+	wavBitsPerSample = 16
+)
+
+// DecodePCM decodes the recording into 16-bit mono PCM at the store's sample rate.
+// Unlike playback, this doesn't need an output device.
+func (r *Recording) DecodePCM() ([]int16, error) {
+	dec, err := opus.NewDecoder(sampleRate, wavChannels)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]int16, decodeBufferSamples(r.FrameSizeMs))
+	var pcm []int16
+	for i, frame := range r.Frames {
+		n, err := dec.Decode(frame, buf)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding frame %d: %w", i, err)
+		}
+		pcm = append(pcm, buf[:n]...)
+	}
+	return pcm, nil
+}
+
+// WriteWAV decodes the recording and writes it to w as a 16-bit mono RIFF/WAV file.
+func (r *Recording) WriteWAV(w io.Writer) error {
+	pcm, err := r.DecodePCM()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(wavHeader(len(pcm))); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.LittleEndian, pcm)
+}
+
+// wavHeader returns the RIFF/WAV header for numSamples of 16-bit mono PCM.
+func wavHeader(numSamples int) []byte {
+	const blockAlign = wavChannels * wavBitsPerSample / 8
+	dataSize := uint32(numSamples * blockAlign)
+
+	header := make([]byte, 44)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], 36+dataSize)
+	copy(header[8:], "WAVE")
+
+	copy(header[12:], "fmt ")
+	binary.LittleEndian.PutUint32(header[16:], 16) // size of the fmt chunk
+	binary.LittleEndian.PutUint16(header[20:], 1)  // PCM
+	binary.LittleEndian.PutUint16(header[22:], wavChannels)
+	binary.LittleEndian.PutUint32(header[24:], sampleRate)
+	binary.LittleEndian.PutUint32(header[28:], sampleRate*blockAlign) // byte rate
+	binary.LittleEndian.PutUint16(header[32:], blockAlign)
+	binary.LittleEndian.PutUint16(header[34:], wavBitsPerSample)
+
+	copy(header[36:], "data")
+	binary.LittleEndian.PutUint32(header[40:], dataSize)
+
+	return header
+}
@@ -0,0 +1,42 @@
+package audio
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWAVHeader(t *testing.T) {
+	// one second of 48 kHz mono audio is 96000 bytes of data
+	header := wavHeader(48000)
+
+	expected := []byte{
+		'R', 'I', 'F', 'F',
+		0x24, 0x77, 0x01, 0x00, // 36 + 96000
+		'W', 'A', 'V', 'E',
+
+		'f', 'm', 't', ' ',
+		0x10, 0x00, 0x00, 0x00, // fmt chunk size
+		0x01, 0x00, // PCM
+		0x01, 0x00, // mono
+		0x80, 0xbb, 0x00, 0x00, // 48000 Hz
+		0x00, 0x77, 0x01, 0x00, // 96000 bytes per second
+		0x02, 0x00, // block align
+		0x10, 0x00, // bits per sample
+
+		'd', 'a', 't', 'a',
+		0x00, 0x77, 0x01, 0x00, // 96000
+	}
+	if !bytes.Equal(header, expected) {
+		t.Fatalf("unexpected wav header:\n got: % x\nwant: % x", header, expected)
+	}
+}
+
+func TestWAVHeaderEmpty(t *testing.T) {
+	header := wavHeader(0)
+	if len(header) != 44 {
+		t.Fatalf("expected a 44 byte header, got %d bytes", len(header))
+	}
+	if !bytes.Equal(header[4:8], []byte{36, 0, 0, 0}) || !bytes.Equal(header[40:44], []byte{0, 0, 0, 0}) {
+		t.Fatalf("unexpected sizes in empty wav header: % x", header)
+	}
+}
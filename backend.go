@@ -5,11 +5,14 @@ import (
 	"github.com/maxence-charriere/go-app/v7/pkg/app"
 	"github.com/yusefnapora/party-line/api"
 	"github.com/yusefnapora/party-line/audio"
+	"github.com/yusefnapora/party-line/messages"
 	"github.com/yusefnapora/party-line/p2p"
 	"github.com/yusefnapora/party-line/types"
 
 	"log"
 	"net/http"
+	"sync"
+	"time"
 )
 
 type PartyLineApp struct {
@@ -18,32 +21,136 @@ type PartyLineApp struct {
 	localUser     *types.UserInfo
 	audioRecorder *audio.Recorder
 	audioStore    *audio.Store
+	audioPlayer   *audio.Player
+	messageStore  *messages.Store
 
 	peer *p2p.PartyLinePeer
 
 	dispatcher *api.Dispatcher
+
+	shutdownOnce sync.Once
 }
 
+// how long to wait for goodbyes & queued messages to be sent on shutdown
+const shutdownTimeout = 5 * time.Second
+
 type PartyLineAppConfig struct {
-	UIPort          int
-	UserNick        string
-	BlockLocalDials bool
+	UIPort          int    `json:"ui_port"`
+	UserNick        string `json:"nick"`
+	BlockLocalDials bool   `json:"no_lan"`
+
+	// DataDir is where we keep state that should survive restarts (identity key, etc).
+	DataDir string `json:"-"`
+
+	// IdentityPath overrides the location of the libp2p identity key. If empty,
+	// the key is stored in DataDir.
+	IdentityPath string `json:"identity"`
+
+	// Relays and BootstrapPeers are multiaddrs with a /p2p/ component.
+	Relays         []string `json:"relays"`
+	BootstrapPeers []string `json:"bootstrap_peers"`
+	DisableDHT     bool     `json:"disable_dht"`
+
+	DisableMDNS    bool `json:"disable_mdns"`
+	AutoConnectLAN bool `json:"lan_auto_connect"`
+
+	// limits for saved recordings. The least recently used ones are deleted once either is reached.
+	// Zero means no limit.
+	AudioQuotaMB  int `json:"audio_quota_mb"`
+	MaxRecordings int `json:"max_recordings"`
+
+	// MaxRecordingDuration is a Go duration string (e.g. "5m") that caps the length of every recording.
+	// Empty or "0" means no limit.
+	MaxRecordingDuration string `json:"max_recording_duration"`
+
+	// Codec controls how the mic is encoded. Fields missing from the config file keep their defaults.
+	Codec audio.CodecProfile `json:"codec"`
+}
+
+func (cfg PartyLineAppConfig) identityPath() string {
+	if cfg.IdentityPath != "" {
+		return cfg.IdentityPath
+	}
+	return p2p.DefaultIdentityPath(cfg.DataDir)
+}
+
+func (cfg PartyLineAppConfig) maxRecordingDuration() (time.Duration, error) {
+	if cfg.MaxRecordingDuration == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(cfg.MaxRecordingDuration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max recording duration: %w", err)
+	}
+	return d, nil
 }
 
 func NewApp(cfg PartyLineAppConfig) (*PartyLineApp, error) {
-	audioStore, err := audio.NewStore()
+	devicePrefs, err := audio.LoadDevicePrefs(audio.DefaultDevicePrefsPath(cfg.DataDir))
+	if err != nil {
+		return nil, err
+	}
+
+	audioStore, err := audio.NewStore(audio.StoreConfig{
+		Dir:           audio.DefaultRecordingsDir(cfg.DataDir),
+		MaxBytes:      int64(cfg.AudioQuotaMB) << 20,
+		MaxRecordings: cfg.MaxRecordings,
+		DevicePrefs:   devicePrefs,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	recorder, err := audio.NewRecorder(audioStore)
+	maxRecordingDuration, err := cfg.maxRecordingDuration()
 	if err != nil {
 		return nil, err
 	}
+	voiceCh := make(chan *types.VoiceFrame, 256)
+	recorder, err := audio.NewRecorder(audioStore, devicePrefs, voiceCh, cfg.Codec, maxRecordingDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := p2p.LoadOrCreateIdentity(cfg.identityPath())
+	if err != nil {
+		return nil, err
+	}
+
+	relays, err := p2p.ParseAddrInfos(cfg.Relays)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing relay addrs: %w", err)
+	}
+	bootstrapPeers, err := p2p.ParseAddrInfos(cfg.BootstrapPeers)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing bootstrap addrs: %w", err)
+	}
+
+	messageStore, err := messages.OpenStore(messages.DefaultStorePath(cfg.DataDir), p2p.VerifyMessage)
+	if err != nil {
+		return nil, fmt.Errorf("error opening message store: %w", err)
+	}
 
 	publishCh := make(chan *types.Message, 1024)
 	dispatcher := api.NewDispatcher(publishCh)
-	peer, err := p2p.NewPeer(dispatcher, publishCh, audioStore, cfg.UserNick, cfg.BlockLocalDials)
+	go messageStore.Record(dispatcher.AddListener("message-store"))
+	player := audio.NewPlayer(audioStore, dispatcher)
+
+	peer, err := p2p.NewPeer(dispatcher, publishCh, voiceCh, audioStore, p2p.Config{
+		Identity:       identity,
+		UserNick:       cfg.UserNick,
+		BlockLAN:       cfg.BlockLocalDials,
+		Relays:         relays,
+		BootstrapPeers: bootstrapPeers,
+		DisableDHT:     cfg.DisableDHT,
+		DisableMDNS:    cfg.DisableMDNS,
+		AutoConnectLAN: cfg.AutoConnectLAN,
+		OutboxDir:      p2p.DefaultOutboxDir(cfg.DataDir),
+		OnPublish: func(msg *types.Message) {
+			if err := messageStore.Add(msg, true); err != nil {
+				fmt.Printf("error saving message: %s\n", err)
+			}
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +165,8 @@ func NewApp(cfg PartyLineAppConfig) (*PartyLineApp, error) {
 		localUser:     localUser,
 		audioRecorder: recorder,
 		audioStore:    audioStore,
+		audioPlayer:   player,
+		messageStore:  messageStore,
 		dispatcher:    dispatcher,
 		peer:          peer,
 	}
@@ -68,6 +177,19 @@ func (a *PartyLineApp) Start() {
 	go a.startUIServer()
 }
 
+// Shutdown tells connected peers we're leaving and closes the libp2p host.
+// It's safe to call more than once.
+func (a *PartyLineApp) Shutdown() {
+	a.shutdownOnce.Do(func() {
+		if err := a.peer.Close(shutdownTimeout); err != nil {
+			fmt.Printf("error closing libp2p host: %s\n", err)
+		}
+		if err := a.messageStore.Close(); err != nil {
+			fmt.Printf("error closing message store: %s\n", err)
+		}
+	})
+}
+
 func (a *PartyLineApp) ConnectToPeers(pidStrs ...string) {
 	for _, p := range pidStrs {
 		if err := a.peer.ConnectToPeerStr(p); err != nil {
@@ -78,7 +200,7 @@ func (a *PartyLineApp) ConnectToPeers(pidStrs ...string) {
 
 func (a *PartyLineApp) startUIServer() {
 	fmt.Printf("starting UI server on localhost:%d\n", a.UIServerPort)
-	apiHandler, err := api.NewHandler("/api", a.localUser, a.audioRecorder, a.audioStore, a.dispatcher)
+	apiHandler, err := api.NewHandler("/api", a.localUser, a.audioRecorder, a.audioStore, a.audioPlayer, a.messageStore, a.dispatcher)
 	if err != nil {
 		panic(err)
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/gogo/protobuf/proto"
 	"github.com/yusefnapora/party-line/types"
+	"net/url"
 	"nhooyr.io/websocket"
 	"nhooyr.io/websocket/wspb"
 	"regexp"
@@ -63,6 +64,37 @@ func (c *Client) StartAudioRecording() (*types.BeginAudioRecordingResponse, erro
 	}
 }
 
+// StartTalking streams the mic live to connected peers until EndAudioRecording is called with the returned id.
+// If save is true, the talk is also kept as a recording, so it can be sent as an attachment afterwards.
+func (c *Client) StartTalking(save bool) (*types.BeginAudioRecordingResponse, error) {
+	req := types.BeginTalkRequest{SaveRecording: save}
+	body, err := proto.Marshal(&req)
+	if err != nil {
+		return nil, err
+	}
+
+	url := c.apiBaseUrl + "begin-talk"
+	resp, err := c.rest.R().EnableTrace().SetBody(body).Post(url)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResp := &types.ApiResponse{}
+	err = proto.Unmarshal(resp.Body(), apiResp)
+	if err != nil {
+		fmt.Printf("error decoding api response: %s\n", err)
+		return nil, err
+	}
+	switch r := apiResp.Resp.(type) {
+	case *types.ApiResponse_Error:
+		return nil, apiError(r.Error.Details)
+	case *types.ApiResponse_BeginAudioRecording:
+		return r.BeginAudioRecording, nil
+	default:
+		return nil, apiError("unexpected response type %T", r)
+	}
+}
+
 func (c *Client) EndAudioRecording(recordingID string) error {
 	req := types.StopAudioRecordingRequest{RecordingId: recordingID}
 	body, err := proto.Marshal(&req)
@@ -93,14 +125,197 @@ func (c *Client) EndAudioRecording(recordingID string) error {
 	}
 }
 
+// PlayAudioRecording queues a recording to play after anything that's already playing.
 func (c *Client) PlayAudioRecording(recordingID string) error {
-	req := types.PlayAudioRecordingRequest{RecordingId: recordingID}
+	return c.PlayAudio(&types.PlayAudioRecordingRequest{RecordingId: recordingID})
+}
+
+// PlayAudioRecordingWithGain queues a recording with its volume scaled by gain, on top of the master gain.
+func (c *Client) PlayAudioRecordingWithGain(recordingID string, gain float64) error {
+	return c.PlayAudio(&types.PlayAudioRecordingRequest{RecordingId: recordingID, Gain: gain})
+}
+
+// PlayAudio asks the backend to play a recording. It returns once the recording is queued;
+// playback events report its progress.
+func (c *Client) PlayAudio(req *types.PlayAudioRecordingRequest) error {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return c.postForOk(c.apiBaseUrl+"play-recording", body)
+}
+
+// PausePlayback pauses the current recording. An empty id means whatever's playing.
+func (c *Client) PausePlayback(recordingID string) error {
+	return c.controlPlayback("pause-playback", recordingID)
+}
+
+// ResumePlayback continues the current recording after PausePlayback. An empty id means whatever's playing.
+func (c *Client) ResumePlayback(recordingID string) error {
+	return c.controlPlayback("resume-playback", recordingID)
+}
+
+// StopPlayback stops a recording or removes it from the queue. An empty id stops everything.
+func (c *Client) StopPlayback(recordingID string) error {
+	return c.controlPlayback("stop-playback", recordingID)
+}
+
+func (c *Client) controlPlayback(endpoint string, recordingID string) error {
+	req := types.PlaybackControlRequest{RecordingId: recordingID}
+	body, err := proto.Marshal(&req)
+	if err != nil {
+		return err
+	}
+	return c.postForOk(c.apiBaseUrl+endpoint, body)
+}
+
+// SeekPlayback moves the current recording to the given frame.
+func (c *Client) SeekPlayback(recordingID string, frameIndex int) error {
+	req := types.SeekPlaybackRequest{RecordingId: recordingID, FrameIndex: uint32(frameIndex)}
+	body, err := proto.Marshal(&req)
+	if err != nil {
+		return err
+	}
+	return c.postForOk(c.apiBaseUrl+"seek-playback", body)
+}
+
+func (c *Client) ListAudioInputs() (*types.InputDeviceList, error) {
+	url := c.apiBaseUrl + "audio-inputs"
+	resp, err := c.rest.R().EnableTrace().Get(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var devices types.InputDeviceList
+	if err = proto.Unmarshal(resp.Body(), &devices); err != nil {
+		return nil, err
+	}
+	return &devices, nil
+}
+
+// SelectAudioInput switches the microphone used for recording. An empty id selects the system default.
+func (c *Client) SelectAudioInput(deviceID string) error {
+	req := types.SelectInputDeviceRequest{DeviceId: deviceID}
 	body, err := proto.Marshal(&req)
 	if err != nil {
 		return err
 	}
 
-	url := c.apiBaseUrl + "play-recording"
+	url := c.apiBaseUrl + "select-audio-input"
+	resp, err := c.rest.R().EnableTrace().SetBody(body).Post(url)
+
+	if err != nil {
+		return err
+	}
+
+	apiResp := &types.ApiResponse{}
+	err = proto.Unmarshal(resp.Body(), apiResp)
+	if err != nil {
+		fmt.Printf("error decoding api response: %s\n", err)
+		return err
+	}
+	switch r := apiResp.Resp.(type) {
+	case *types.ApiResponse_Error:
+		return apiError(r.Error.Details)
+	case *types.ApiResponse_Ok:
+		return nil
+	default:
+		return apiError("unexpected response type %T", r)
+	}
+}
+
+func (c *Client) ListAudioOutputs() (*types.OutputDeviceList, error) {
+	url := c.apiBaseUrl + "audio-outputs"
+	resp, err := c.rest.R().EnableTrace().Get(url)
+
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, apiError("error listing audio outputs: %s", resp.String())
+	}
+
+	var devices types.OutputDeviceList
+	if err = proto.Unmarshal(resp.Body(), &devices); err != nil {
+		return nil, err
+	}
+	return &devices, nil
+}
+
+// SelectAudioOutput switches the device used for playback. An empty id selects the system default.
+func (c *Client) SelectAudioOutput(deviceID string) error {
+	req := types.SelectOutputDeviceRequest{DeviceId: deviceID}
+	body, err := proto.Marshal(&req)
+	if err != nil {
+		return err
+	}
+	return c.postForOk(c.apiBaseUrl+"select-audio-output", body)
+}
+
+func (c *Client) GetPlaybackSettings() (*types.PlaybackSettings, error) {
+	url := c.apiBaseUrl + "playback-settings"
+	resp, err := c.rest.R().EnableTrace().Get(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var settings types.PlaybackSettings
+	if err = proto.Unmarshal(resp.Body(), &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// SetMasterGain scales the volume of all playback. 1 leaves it unchanged.
+func (c *Client) SetMasterGain(gain float64) error {
+	req := types.SetMasterGainRequest{Gain: gain}
+	body, err := proto.Marshal(&req)
+	if err != nil {
+		return err
+	}
+	return c.postForOk(c.apiBaseUrl+"set-master-gain", body)
+}
+
+// LiveStreamStats returns the stats of live audio from peers, for streams that are playing and ones that
+// ended recently.
+func (c *Client) LiveStreamStats() (*types.LiveStreamStatsList, error) {
+	url := c.apiBaseUrl + "live-stats"
+	resp, err := c.rest.R().EnableTrace().Get(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var stats types.LiveStreamStatsList
+	if err = proto.Unmarshal(resp.Body(), &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// SetPeerGain scales the volume of live audio from a peer. 1 is unchanged.
+func (c *Client) SetPeerGain(peerID string, gain float64) error {
+	req := types.SetPeerGainRequest{PeerId: peerID, Gain: gain}
+	body, err := proto.Marshal(&req)
+	if err != nil {
+		return err
+	}
+	return c.postForOk(c.apiBaseUrl+"set-peer-gain", body)
+}
+
+func (c *Client) SetMuted(muted bool) error {
+	req := types.SetMutedRequest{Muted: muted}
+	body, err := proto.Marshal(&req)
+	if err != nil {
+		return err
+	}
+	return c.postForOk(c.apiBaseUrl+"set-muted", body)
+}
+
+// postForOk posts body to url, for endpoints that respond with an empty ok or an error.
+func (c *Client) postForOk(url string, body []byte) error {
 	resp, err := c.rest.R().EnableTrace().SetBody(body).Post(url)
 
 	if err != nil {
@@ -196,6 +411,130 @@ func (c *Client) ConnectToPeer(peerLocator string) error {
 	}
 }
 
+func (c *Client) JoinRoom(roomName string) error {
+	url := c.apiBaseUrl + "join-room"
+	req := &types.JoinRoomRequest{RoomName: roomName}
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.rest.R().EnableTrace().SetBody(body).Post(url)
+	if err != nil {
+		return err
+	}
+
+	apiResp := &types.ApiResponse{}
+	err = proto.Unmarshal(resp.Body(), apiResp)
+	if err != nil {
+		fmt.Printf("error decoding api response: %s\n", err)
+		return err
+	}
+	switch r := apiResp.Resp.(type) {
+	case *types.ApiResponse_Error:
+		return apiError(r.Error.Details)
+	case *types.ApiResponse_Ok:
+		return nil
+	default:
+		return apiError("unexpected response type %T", r)
+	}
+}
+
+// MarkMessageRead tells the author of msg that we've seen it.
+func (c *Client) MarkMessageRead(msg *types.Message) error {
+	url := c.apiBaseUrl + "mark-message-read"
+	req := &types.MarkMessageReadRequest{MessageId: msg.MessageId, Author: msg.Author}
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.rest.R().EnableTrace().SetBody(body).Post(url)
+	if err != nil {
+		return err
+	}
+
+	apiResp := &types.ApiResponse{}
+	err = proto.Unmarshal(resp.Body(), apiResp)
+	if err != nil {
+		fmt.Printf("error decoding api response: %s\n", err)
+		return err
+	}
+	switch r := apiResp.Resp.(type) {
+	case *types.ApiResponse_Error:
+		return apiError(r.Error.Details)
+	case *types.ApiResponse_Ok:
+		return nil
+	default:
+		return apiError("unexpected response type %T", r)
+	}
+}
+
+// MessageHistory returns up to limit stored messages from before the message with id beforeID and the given
+// author, or the most recent messages if beforeID is empty.
+func (c *Client) MessageHistory(beforeAuthor string, beforeID string, limit int) (*types.MessageHistoryPage, error) {
+	url := c.apiBaseUrl + "message-history"
+	req := &types.MessageHistoryRequest{BeforeAuthorPeerId: beforeAuthor, BeforeMessageId: beforeID, Limit: int32(limit)}
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.rest.R().EnableTrace().SetBody(body).Post(url)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResp := &types.ApiResponse{}
+	err = proto.Unmarshal(resp.Body(), apiResp)
+	if err != nil {
+		fmt.Printf("error decoding api response: %s\n", err)
+		return nil, err
+	}
+	switch r := apiResp.Resp.(type) {
+	case *types.ApiResponse_Error:
+		return nil, apiError(r.Error.Details)
+	case *types.ApiResponse_MessageHistory:
+		return r.MessageHistory, nil
+	default:
+		return nil, apiError("unexpected response type %T", r)
+	}
+}
+
+// RecordingDownloadURL returns a URL that serves the recording as an Ogg Opus file.
+func (c *Client) RecordingDownloadURL(recordingID string) string {
+	return c.apiBaseUrl + "download-recording?id=" + url.QueryEscape(recordingID)
+}
+
+// RecordingWAVDownloadURL returns a URL that serves the decoded recording as a 16-bit WAV file.
+func (c *Client) RecordingWAVDownloadURL(recordingID string) string {
+	return c.RecordingDownloadURL(recordingID) + "&format=wav"
+}
+
+// UploadRecording imports an Ogg Opus file, so it can be sent as an audio attachment.
+func (c *Client) UploadRecording(oggData []byte) (*types.ImportAudioResponse, error) {
+	url := c.apiBaseUrl + "upload-recording"
+	resp, err := c.rest.R().EnableTrace().SetHeader("Content-Type", "audio/ogg").SetBody(oggData).Post(url)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResp := &types.ApiResponse{}
+	err = proto.Unmarshal(resp.Body(), apiResp)
+	if err != nil {
+		fmt.Printf("error decoding api response: %s\n", err)
+		return nil, err
+	}
+	switch r := apiResp.Resp.(type) {
+	case *types.ApiResponse_Error:
+		return nil, apiError(r.Error.Details)
+	case *types.ApiResponse_ImportAudio:
+		return r.ImportAudio, nil
+	default:
+		return nil, apiError("unexpected response type %T", r)
+	}
+}
+
 func removeScheme(url string) string {
 	re, err := regexp.Compile("^http(s)?://")
 	if err != nil {
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yusefnapora/party-line/audio"
+	"github.com/yusefnapora/party-line/p2p"
+)
+
+const configFileName = "config.json"
+
+// DefaultConfig returns the config used when nothing is set in the config file or on the command line.
+func DefaultConfig() PartyLineAppConfig {
+	osUser, found := os.LookupEnv("USER")
+	if !found {
+		osUser = "unknown user"
+	}
+
+	return PartyLineAppConfig{
+		UIPort:         7777,
+		UserNick:       osUser,
+		DataDir:        defaultDataDir(),
+		Relays:         p2p.DefaultRelays,
+		BootstrapPeers: p2p.DefaultBootstrapPeers(),
+		AudioQuotaMB:   500,
+
+		MaxRecordingDuration: "5m",
+		Codec:                audio.DefaultCodecProfile(),
+	}
+}
+
+func defaultDataDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ".party-line"
+	}
+	return filepath.Join(dir, "party-line")
+}
+
+func defaultConfigPath(dataDir string) string {
+	return filepath.Join(dataDir, configFileName)
+}
+
+// LoadFile overwrites any values in cfg that are set in the JSON config file at path.
+// A missing file is only an error if mustExist is true.
+func (cfg *PartyLineAppConfig) LoadFile(path string, mustExist bool) error {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && !mustExist {
+			return nil
+		}
+		return err
+	}
+
+	if err := json.Unmarshal(buf, cfg); err != nil {
+		return fmt.Errorf("error parsing config file %s: %w", path, err)
+	}
+	fmt.Printf("loaded config from %s\n", path)
+	return nil
+}
+
+// stringList is a flag.Value that collects repeated or comma-separated flag values.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(s string) error {
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
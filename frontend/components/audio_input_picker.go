@@ -0,0 +1,62 @@
+package components
+
+import (
+	"github.com/maxence-charriere/go-app/v7/pkg/app"
+	"github.com/yusefnapora/party-line/types"
+)
+
+// AudioInputPickerView lets the user choose which microphone to record from.
+type AudioInputPickerView struct {
+	app.Compo
+
+	devices []*types.InputDeviceInfo
+
+	onSelect func(deviceID string)
+}
+
+func AudioInputPicker(onSelect func(deviceID string)) *AudioInputPickerView {
+	return &AudioInputPickerView{
+		onSelect: onSelect,
+	}
+}
+
+func (v *AudioInputPickerView) SetDevices(devices []*types.InputDeviceInfo) {
+	v.devices = devices
+	v.Update()
+}
+
+func (v *AudioInputPickerView) Render() app.UI {
+	return app.Div().Class("audio-input-picker").Body(
+		app.H4().Body(app.Text("Microphone")),
+
+		app.If(len(v.devices) == 0,
+			app.Span().Class("audio-input-empty").Body(app.Text("no audio inputs found")),
+		).Else(
+			app.Select().
+				OnChange(v.onChange).
+				Body(
+					app.Range(v.devices).Slice(func(i int) app.UI {
+						dev := v.devices[i]
+						name := dev.Name
+						if dev.IsDefault {
+							name += " (default)"
+						}
+						return app.Option().
+							Value(dev.DeviceId).
+							Selected(dev.IsSelected).
+							Body(app.Text(name))
+					}),
+				),
+		),
+	)
+}
+
+func (v *AudioInputPickerView) onChange(ctx app.Context, e app.Event) {
+	deviceID := ctx.JSSrc.Get("value").String()
+	for _, dev := range v.devices {
+		dev.IsSelected = dev.DeviceId == deviceID
+	}
+	if v.onSelect != nil {
+		v.onSelect(deviceID)
+	}
+}
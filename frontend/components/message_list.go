@@ -2,12 +2,25 @@
 package components
 
 import (
+	"fmt"
 	"github.com/maxence-charriere/go-app/v7/pkg/app"
 	"github.com/yusefnapora/party-line/types"
+	"sort"
 	"sync"
 )
 
 type attachmentClickHandler func(attachment *types.Attachment)
+type messageReadHandler func(msg *types.Message)
+type attachmentURLFunc func(attachment *types.Attachment) string
+type playbackSeekHandler func(recordingID string, frameIndex int)
+type playbackStopHandler func(recordingID string)
+
+// PlaybackState is how far the backend has got playing an audio attachment.
+type PlaybackState struct {
+	FrameIndex  int
+	TotalFrames int
+	Paused      bool
+}
 
 type MessageListView struct {
 	app.Compo
@@ -17,7 +30,30 @@ type MessageListView struct {
 	msgLk    sync.RWMutex
 	messages []*types.Message
 
+	// keys (see types.MessageKey) of the messages we're showing, so we don't show a message twice
+	// if it comes from the message history and an event
+	messageIDs map[string]bool
+
+	// messages without a valid signature from their author
+	unverified map[*types.Message]bool
+
+	// receipts for our own messages, by message id and then recipient peer id
+	receipts map[string]map[string]*types.Receipt
+
+	// outbox status of our own messages for peers that were offline, by message id and then recipient peer id
+	outboxStatus map[string]map[string]*types.OutboxStatusChangedEvent
+
+	// keys of peer messages we've already reported as read
+	readIDs map[string]bool
+
+	// recordings that are playing or paused, by recording id
+	playback map[string]*PlaybackState
+
 	onAttachmentClick attachmentClickHandler
+	onMessageRead     messageReadHandler
+	attachmentURL     attachmentURLFunc
+	onPlaybackSeek    playbackSeekHandler
+	onPlaybackStop    playbackStopHandler
 }
 
 func (v *MessageListView) Render() app.UI {
@@ -30,68 +66,302 @@ func (v *MessageListView) Render() app.UI {
 			return &MessageView{
 				msg:               msg,
 				fromSelf:          msg.Author.PeerId == v.localPeerID,
+				unverified:        v.unverified[msg],
+				list:              v,
 				onAttachmentClick: v.onAttachmentClick,
 			}
 		}))
 }
 
-func MessageList(localPeer string, messages []*types.Message, onAttachmentClick attachmentClickHandler) *MessageListView {
+func MessageList(localPeer string, messages []*types.Message, onAttachmentClick attachmentClickHandler, onMessageRead messageReadHandler, attachmentURL attachmentURLFunc, onPlaybackSeek playbackSeekHandler, onPlaybackStop playbackStopHandler) *MessageListView {
 	return &MessageListView{
 		localPeerID:       localPeer,
 		messages:          messages,
+		messageIDs:        make(map[string]bool),
+		unverified:        make(map[*types.Message]bool),
+		receipts:          make(map[string]map[string]*types.Receipt),
+		outboxStatus:      make(map[string]map[string]*types.OutboxStatusChangedEvent),
+		readIDs:           make(map[string]bool),
+		playback:          make(map[string]*PlaybackState),
 		onAttachmentClick: onAttachmentClick,
+		onMessageRead:     onMessageRead,
+		attachmentURL:     attachmentURL,
+		onPlaybackSeek:    onPlaybackSeek,
+		onPlaybackStop:    onPlaybackStop,
 	}
 }
 
-func (v *MessageListView) AddMessage(msg *types.Message) {
+func (v *MessageListView) AddMessage(msg *types.Message, verified bool) {
 	v.msgLk.Lock()
 	defer v.msgLk.Unlock()
+	v.addMessage(msg, verified)
+	v.Update()
+}
+
+// AddStoredMessages shows messages loaded from the message history. They're treated as already read,
+// so we don't send read receipts for old messages every time the app starts.
+func (v *MessageListView) AddStoredMessages(stored []*types.StoredMessage) {
+	v.msgLk.Lock()
+	defer v.msgLk.Unlock()
+	for _, s := range stored {
+		v.addMessage(s.Message, s.Verified)
+		if key := types.MessageKey(s.Message); key != "" {
+			v.readIDs[key] = true
+		}
+	}
+	v.Update()
+}
+
+// addMessage must be called with msgLk held.
+func (v *MessageListView) addMessage(msg *types.Message, verified bool) {
+	if key := types.MessageKey(msg); key != "" {
+		if v.messageIDs[key] {
+			return
+		}
+		v.messageIDs[key] = true
+	}
 	v.messages = append(v.messages, msg)
+	if !verified {
+		v.unverified[msg] = true
+	}
+}
+
+// AddReceipt records a delivery or read receipt for one of our own messages.
+func (v *MessageListView) AddReceipt(r *types.Receipt) {
+	if r.Recipient == nil {
+		return
+	}
+
+	v.msgLk.Lock()
+	defer v.msgLk.Unlock()
+
+	byRecipient, ok := v.receipts[r.MessageId]
+	if !ok {
+		byRecipient = make(map[string]*types.Receipt)
+		v.receipts[r.MessageId] = byRecipient
+	}
+	// a delivery receipt can arrive after the read receipt, but shouldn't replace it
+	if existing, ok := byRecipient[r.Recipient.PeerId]; ok && existing.Type == types.ReceiptType_READ {
+		return
+	}
+	byRecipient[r.Recipient.PeerId] = r
+	v.Update()
+}
+
+// SetOutboxStatus records that one of our messages is waiting for a peer that's offline, or was
+// given up on. Once the message is delivered, the peer's receipt takes over.
+func (v *MessageListView) SetOutboxStatus(e *types.OutboxStatusChangedEvent) {
+	if e.Recipient == nil {
+		return
+	}
+
+	v.msgLk.Lock()
+	defer v.msgLk.Unlock()
+
+	byRecipient, ok := v.outboxStatus[e.MessageId]
+	if !ok {
+		byRecipient = make(map[string]*types.OutboxStatusChangedEvent)
+		v.outboxStatus[e.MessageId] = byRecipient
+	}
+	if e.Status == types.OutboxStatus_OUTBOX_DELIVERED {
+		delete(byRecipient, e.Recipient.PeerId)
+	} else {
+		byRecipient[e.Recipient.PeerId] = e
+	}
+	v.Update()
+}
+
+// SetPlaybackState updates the progress bar of an audio attachment.
+func (v *MessageListView) SetPlaybackState(recordingID string, state PlaybackState) {
+	v.msgLk.Lock()
+	defer v.msgLk.Unlock()
+	v.playback[recordingID] = &state
+	v.Update()
+}
+
+// ClearPlaybackState hides the progress bar once a recording has finished or been stopped.
+func (v *MessageListView) ClearPlaybackState(recordingID string) {
+	v.msgLk.Lock()
+	defer v.msgLk.Unlock()
+	delete(v.playback, recordingID)
 	v.Update()
 }
 
+// PlaybackState returns the playback state of the recording with the given id, if it's playing or paused.
+func (v *MessageListView) PlaybackState(recordingID string) (PlaybackState, bool) {
+	v.msgLk.RLock()
+	defer v.msgLk.RUnlock()
+	state, ok := v.playback[recordingID]
+	if !ok {
+		return PlaybackState{}, false
+	}
+	return *state, true
+}
+
+// deliveryState is shown next to one of our own messages for each recipient.
+type deliveryState struct {
+	user  *types.UserInfo
+	icon  string
+	title string
+}
+
+func (v *MessageListView) deliveryStatesFor(messageID string) []deliveryState {
+	v.msgLk.RLock()
+	defer v.msgLk.RUnlock()
+
+	var states []deliveryState
+	for _, r := range v.receipts[messageID] {
+		s := deliveryState{user: r.Recipient, icon: "fa-check", title: "Delivered to "}
+		if r.Type == types.ReceiptType_READ {
+			s.icon = "fa-check-double"
+			s.title = "Read by "
+		}
+		states = append(states, s)
+	}
+	for peerID, e := range v.outboxStatus[messageID] {
+		if _, ok := v.receipts[messageID][peerID]; ok {
+			continue
+		}
+		s := deliveryState{user: e.Recipient, icon: "fa-clock", title: "Waiting to send to "}
+		if e.Status == types.OutboxStatus_OUTBOX_EXPIRED {
+			s.icon = "fa-times"
+			s.title = "Couldn't deliver to "
+		}
+		states = append(states, s)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].user.PeerId < states[j].user.PeerId
+	})
+	return states
+}
+
+// messageDisplayed calls the read handler the first time a message from another peer is shown.
+func (v *MessageListView) messageDisplayed(msg *types.Message) {
+	if msg.MessageId == "" || v.onMessageRead == nil {
+		return
+	}
+
+	v.msgLk.Lock()
+	key := types.MessageKey(msg)
+	alreadyRead := v.readIDs[key]
+	v.readIDs[key] = true
+	v.msgLk.Unlock()
+
+	if !alreadyRead {
+		v.onMessageRead(msg)
+	}
+}
+
 type MessageView struct {
 	app.Compo
 
-	msg      *types.Message
-	fromSelf bool
+	msg        *types.Message
+	fromSelf   bool
+	unverified bool
+
+	// go-app doesn't copy unexported fields when re-rendering a mounted component,
+	// so receipts are looked up from the list on each render instead of being passed in.
+	list *MessageListView
 
 	onAttachmentClick attachmentClickHandler
 }
 
+func (v *MessageView) OnMount(ctx app.Context) {
+	if !v.fromSelf {
+		v.list.messageDisplayed(v.msg)
+	}
+}
+
 func (v *MessageView) Render() app.UI {
 	msgTypeClass := "peer-message"
 	if v.fromSelf {
 		msgTypeClass = "self-message"
 	}
 
+	verifiedClass := ""
+	if v.unverified {
+		verifiedClass = "unverified-message"
+	}
+
 	return app.Div().
 		Class("message-bubble").
 		Class(msgTypeClass).
+		Class(verifiedClass).
 		Body(
 			UserAvatar(v.msg.Author, 32),
 
 			app.Span().Body(
 				app.Text(v.msg.Author.Nickname),
+				app.If(v.unverified,
+					app.Span().Title("This message isn't signed by its author").Body(
+						Icon("fas fa-exclamation-triangle"))),
 			).Class("author-name"),
 
 			app.Text(v.msg.TextContent),
 
 			app.If(len(v.msg.Attachments) > 0, app.Range(v.msg.Attachments).Slice(func(i int) app.UI {
 				a := v.msg.Attachments[i]
-				return &MessageAttachmentView{attachment: a, clickHandler: v.onAttachmentClick}
-			})))
+				downloadURL := ""
+				if v.list.attachmentURL != nil {
+					downloadURL = v.list.attachmentURL(a)
+				}
+				return &MessageAttachmentView{attachment: a, clickHandler: v.onAttachmentClick, downloadURL: downloadURL, list: v.list}
+			})),
+
+			app.If(v.fromSelf, v.renderReceipts()))
+}
+
+func (v *MessageView) renderReceipts() app.UI {
+	states := v.list.deliveryStatesFor(v.msg.MessageId)
+	return app.Div().Class("message-receipts").Body(
+		app.Range(states).Slice(func(i int) app.UI {
+			s := states[i]
+			name := nicknameOrPlaceholder(s.user)
+			// plain elements instead of an IconView, so the icon changes along with the state
+			return app.Span().Class("receipt").Title(s.title+name).Body(
+				app.Span().Class("fas").Class(s.icon),
+				app.Text(name))
+		}))
 }
 
 type MessageAttachmentView struct {
 	app.Compo
 	attachment   *types.Attachment
 	clickHandler attachmentClickHandler
+	downloadURL  string
+
+	// the playback state is looked up from the list on each render, like a MessageView's receipts
+	list *MessageListView
 }
 
 func (v *MessageAttachmentView) Render() app.UI {
-	return app.Div().
-		Body(Icon("fas fa-file-audio")).OnClick(v.onClick)
+	state, playing := v.list.PlaybackState(v.attachment.Id)
+
+	icon, title := "fa-file-audio", "Play"
+	if playing && state.Paused {
+		icon, title = "fa-play", "Resume"
+	} else if playing {
+		icon, title = "fa-pause", "Pause"
+	}
+
+	progress := 0
+	if state.TotalFrames > 0 {
+		progress = state.FrameIndex * 100 / state.TotalFrames
+	}
+
+	return app.Div().Class("message-attachment").Body(
+		// plain elements instead of an IconView, so the icon changes along with the playback state
+		app.Span().Class("attachment-play").Title(title).OnClick(v.onClick).Body(
+			app.Span().Class("fas").Class(icon)),
+		app.If(playing,
+			app.Div().Class("playback-progress").Title("Click to seek").OnClick(v.onProgressClick).Body(
+				app.Div().Class("playback-progress-fill").Style("width", fmt.Sprintf("%d%%", progress))),
+			app.Span().Class("attachment-stop").Title("Stop").OnClick(v.onStopClick).Body(
+				app.Span().Class("fas").Class("fa-stop")),
+		),
+		app.If(v.downloadURL != "",
+			app.A().Class("attachment-download").Href(v.downloadURL).Download(true).Title("Save as .opus").Body(
+				Icon("fas fa-download"))))
 }
 
 func (v *MessageAttachmentView) onClick(ctx app.Context, e app.Event) {
@@ -99,3 +369,20 @@ func (v *MessageAttachmentView) onClick(ctx app.Context, e app.Event) {
 		v.clickHandler(v.attachment)
 	}
 }
+
+func (v *MessageAttachmentView) onProgressClick(ctx app.Context, e app.Event) {
+	state, playing := v.list.PlaybackState(v.attachment.Id)
+	width := ctx.JSSrc.Get("offsetWidth").Float()
+	if !playing || width <= 0 || v.list.onPlaybackSeek == nil {
+		return
+	}
+	// the fill ignores pointer events, so offsetX is always relative to the bar
+	fraction := e.Get("offsetX").Float() / width
+	v.list.onPlaybackSeek(v.attachment.Id, int(fraction*float64(state.TotalFrames)))
+}
+
+func (v *MessageAttachmentView) onStopClick(ctx app.Context, e app.Event) {
+	if v.list.onPlaybackStop != nil {
+		v.list.onPlaybackStop(v.attachment.Id)
+	}
+}
@@ -0,0 +1,70 @@
+package components
+
+import (
+	"fmt"
+	"github.com/maxence-charriere/go-app/v7/pkg/app"
+	"github.com/yusefnapora/party-line/types"
+	"sort"
+)
+
+type NetworkStatusView struct {
+	app.Compo
+
+	relayAddrs []string
+	natTypes   map[string]*types.NATTypeDetectedEvent
+}
+
+func NetworkStatus() *NetworkStatusView {
+	return &NetworkStatusView{
+		natTypes: make(map[string]*types.NATTypeDetectedEvent),
+	}
+}
+
+func (v *NetworkStatusView) AddRelayAddr(addr string) {
+	v.relayAddrs = append(v.relayAddrs, addr)
+	v.Update()
+}
+
+func (v *NetworkStatusView) SetNATType(evt *types.NATTypeDetectedEvent) {
+	v.natTypes[evt.TransportProtocol] = evt
+	v.Update()
+}
+
+func (v *NetworkStatusView) Render() app.UI {
+	relayStatus := "waiting for relay address..."
+	if len(v.relayAddrs) > 0 {
+		relayStatus = fmt.Sprintf("reachable via %d relay address(es)", len(v.relayAddrs))
+	}
+
+	var transports []string
+	for t := range v.natTypes {
+		transports = append(transports, t)
+	}
+	sort.Strings(transports)
+
+	var natLines []string
+	for _, transport := range transports {
+		evt := v.natTypes[transport]
+		support := "supports"
+		if !evt.HolePunchingSupported {
+			support = "does NOT support"
+		}
+		natLines = append(natLines, fmt.Sprintf("%s: %s NAT, %s hole punching", transport, evt.NatDeviceType, support))
+	}
+
+	return app.Div().Class("network-status-view").Body(
+		app.Span().Class("network-status-line").Body(app.Text(relayStatus)),
+
+		app.Range(v.relayAddrs).Slice(func(i int) app.UI {
+			return app.Span().Class("network-status-addr").Body(app.Text(v.relayAddrs[i]))
+		}),
+
+		app.If(len(natLines) == 0,
+			app.Span().Class("network-status-line").Body(app.Text("detecting NAT type...")),
+		).Else(
+			app.Range(natLines).Slice(func(i int) app.UI {
+				return app.Span().Class("network-status-line").Body(app.Text(natLines[i]))
+			}),
+		),
+	)
+}
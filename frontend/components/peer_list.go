@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/maxence-charriere/go-app/v7/pkg/app"
 	"github.com/yusefnapora/party-line/types"
+	"strings"
 )
 
 type PeerListView struct {
@@ -11,13 +12,25 @@ type PeerListView struct {
 
 	users []*types.UserInfo
 
-	newPeerRequested func(string)
+	// users found on the local network that we may not be connected to yet
+	nearby []*types.UserInfo
+
+	// names of rooms we've asked to join
+	rooms []string
+
+	// peer id -> state of our connection to them
+	connStates map[string]types.ConnectionState
+
+	newPeerRequested  func(string)
+	joinRoomRequested func(string)
 }
 
-func PeerList(users []*types.UserInfo, onNewPeerRequested func(string)) *PeerListView {
+func PeerList(users []*types.UserInfo, onNewPeerRequested func(string), onJoinRoomRequested func(string)) *PeerListView {
 	return &PeerListView{
-		users: users,
-		newPeerRequested: onNewPeerRequested,
+		users:             users,
+		connStates:        make(map[string]types.ConnectionState),
+		newPeerRequested:  onNewPeerRequested,
+		joinRoomRequested: onJoinRoomRequested,
 	}
 }
 
@@ -26,14 +39,58 @@ func (v *PeerListView) Render() app.UI {
 		app.H3().Body(app.Text("Peers")),
 
 		app.Range(v.users).Slice(func(i int) app.UI {
-			return UserCard(v.users[i])
+			user := v.users[i]
+			card := UserCard(user)
+			if state, ok := v.connStates[user.PeerId]; ok {
+				card.State(state)
+			}
+			return card
 		}),
 
+		app.If(len(v.unconnectedNearby()) > 0,
+			app.H4().Body(app.Text("Nearby")),
+			app.Range(v.unconnectedNearby()).Slice(func(i int) app.UI {
+				user := v.unconnectedNearby()[i]
+				return app.Div().Class("nearby-peer").Title("Click to connect").
+					OnClick(func(ctx app.Context, e app.Event) {
+						v.nearbyPeerClicked(user)
+					}).
+					Body(UserCard(user))
+			}),
+		),
+
 		app.Input().Class("new-peer-input").
 			Placeholder("Enter a peer id / multiaddr to connect").OnChange(v.newPeerTextChanged),
+
+		app.If(len(v.rooms) > 0,
+			app.H4().Body(app.Text("Rooms")),
+			app.Range(v.rooms).Slice(func(i int) app.UI {
+				return app.Span().Class("room-name").Body(app.Text(v.rooms[i]))
+			}),
+		),
+
+		app.Input().Class("new-peer-input").
+			Placeholder("Enter a room name to join").OnChange(v.roomTextChanged),
 		)
 }
 
+func (v *PeerListView) roomTextChanged(ctx app.Context, e app.Event) {
+	text := strings.TrimSpace(ctx.JSSrc.Get("value").String())
+	ctx.JSSrc.Set("value", "")
+	if text == "" || v.joinRoomRequested == nil {
+		return
+	}
+	v.joinRoomRequested(text)
+
+	for _, r := range v.rooms {
+		if r == text {
+			return
+		}
+	}
+	v.rooms = append(v.rooms, text)
+	v.Update()
+}
+
 func (v *PeerListView) newPeerTextChanged(ctx app.Context, e app.Event) {
 	text := ctx.JSSrc.Get("value").String()
 	if v.newPeerRequested != nil {
@@ -42,6 +99,31 @@ func (v *PeerListView) newPeerTextChanged(ctx app.Context, e app.Event) {
 	ctx.JSSrc.Set("value", "")
 }
 
+func (v *PeerListView) nearbyPeerClicked(user *types.UserInfo) {
+	if v.newPeerRequested != nil {
+		v.newPeerRequested(user.PeerId)
+	}
+}
+
+func (v *PeerListView) unconnectedNearby() []*types.UserInfo {
+	var out []*types.UserInfo
+	for _, n := range v.nearby {
+		if !v.hasUser(n.PeerId) {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+func (v *PeerListView) hasUser(peerId string) bool {
+	for _, u := range v.users {
+		if u.PeerId == peerId {
+			return true
+		}
+	}
+	return false
+}
+
 func (v *PeerListView) SetUsers(users []*types.UserInfo) {
 	v.users = users
 	v.Update()
@@ -58,6 +140,34 @@ func (v *PeerListView) AddUser(info *types.UserInfo) {
 	v.Update()
 }
 
+func (v *PeerListView) RemoveUser(info *types.UserInfo) {
+	for i, u := range v.users {
+		if u.PeerId == info.PeerId {
+			v.users = append(v.users[:i], v.users[i+1:]...)
+			v.Update()
+			return
+		}
+	}
+}
+
+func (v *PeerListView) SetConnectionState(info *types.UserInfo, state types.ConnectionState) {
+	v.connStates[info.PeerId] = state
+	v.Update()
+}
+
+func (v *PeerListView) AddNearbyUser(info *types.UserInfo) {
+	for i, n := range v.nearby {
+		if n.PeerId == info.PeerId {
+			v.nearby[i] = info
+			v.Update()
+			return
+		}
+	}
+
+	v.nearby = append(v.nearby, info)
+	v.Update()
+}
+
 type UserAvatarView struct {
 	app.Compo
 
@@ -76,7 +186,7 @@ func (v *UserAvatarView) Render() app.UI {
 	// go-app doesn't have Svg tags, so we construct raw html
 	const cssClass = "user-avatar"
 	html := fmt.Sprintf(`<svg data-jdenticon-value="%s" width="%d" height="%d" class="%s">Avatar for %s</svg>`,
-		v.user.PeerId, v.size, v.size, cssClass, v.user.Nickname)
+		v.user.PeerId, v.size, v.size, cssClass, nicknameOrPlaceholder(v.user))
 
 	return app.Raw(html)
 }
@@ -84,13 +194,21 @@ func (v *UserAvatarView) Render() app.UI {
 type UserCardView struct {
 	app.Compo
 
-	user *types.UserInfo
+	user     *types.UserInfo
+	state    types.ConnectionState
+	hasState bool
 }
 
 func UserCard(user *types.UserInfo) *UserCardView {
 	return &UserCardView{user: user}
 }
 
+func (v *UserCardView) State(state types.ConnectionState) *UserCardView {
+	v.state = state
+	v.hasState = true
+	return v
+}
+
 func (v *UserCardView) Render() app.UI {
 	idlen := len(v.user.PeerId)
 	shortID := v.user.PeerId[idlen-8 : idlen]
@@ -102,10 +220,24 @@ func (v *UserCardView) Render() app.UI {
 
 		app.Div().Class("user-card-text").Body(
 			app.Span().Class("user-card-nickname").Body(
-				app.Text(v.user.Nickname)),
+				app.Text(nicknameOrPlaceholder(v.user))),
 
 			app.Span().Class("user-card-peerid").Body(
 				app.Text(shortID)),
+
+			app.If(v.hasState,
+				app.Span().Class("user-card-state").Body(
+					app.Text(strings.ToLower(v.state.String()))),
+			),
 		),
 	)
 }
+
+// nicknameOrPlaceholder returns the user's nickname, or a placeholder if we haven't heard it yet
+// (e.g. for peers found via local discovery that we haven't said hello to).
+func nicknameOrPlaceholder(user *types.UserInfo) string {
+	if user.Nickname == "" {
+		return "(unknown)"
+	}
+	return user.Nickname
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/maxence-charriere/go-app/v7/pkg/app"
 	"github.com/yusefnapora/party-line/client"
 	"github.com/yusefnapora/party-line/types"
+	"sort"
 	"time"
 )
 
@@ -16,11 +17,21 @@ type RootView struct {
 	isRecording        bool
 	currentRecordingID string
 
+	// push-to-talk state. A talk is a recording that's streamed live to peers while the button is held.
+	isTalking     bool
+	currentTalkID string
+	saveTalks     bool
+
+	// peers that are talking to us right now, by talk id
+	talkers map[string]*types.UserInfo
+
 	evtCh        <-chan *types.Event
 	evtCancelSub func()
 
-	peerListView    *PeerListView
-	messageListView *MessageListView
+	peerListView      *PeerListView
+	messageListView   *MessageListView
+	networkStatusView *NetworkStatusView
+	audioInputView    *AudioInputPickerView
 
 	me *types.UserInfo
 }
@@ -29,9 +40,12 @@ func Root(apiClient *client.Client, me *types.UserInfo) *RootView {
 	v := &RootView{
 		apiClient: apiClient,
 		me:        me,
+		talkers:   make(map[string]*types.UserInfo),
 	}
-	v.messageListView = MessageList(me.PeerId, nil, v.handleAttachmentClick)
-	v.peerListView = PeerList([]*types.UserInfo{me}, v.handleNewPeerRequested)
+	v.messageListView = MessageList(me.PeerId, nil, v.handleAttachmentClick, v.handleMessageRead, v.attachmentDownloadURL, v.handlePlaybackSeek, v.handlePlaybackStop)
+	v.peerListView = PeerList([]*types.UserInfo{me}, v.handleNewPeerRequested, v.handleJoinRoomRequested)
+	v.networkStatusView = NetworkStatus()
+	v.audioInputView = AudioInputPicker(v.handleAudioInputSelected)
 	return v
 }
 
@@ -44,7 +58,48 @@ func (v *RootView) OnMount(ctx app.Context) {
 		return
 	}
 
-	go v.readEvents(ctx)
+	go v.loadAudioInputs()
+
+	go func() {
+		// we're already subscribed, so nothing sent or received while the history loads is missed
+		v.loadMessageHistory()
+		v.readEvents(ctx)
+	}()
+}
+
+// how many of the most recent messages to show when the UI loads
+const historyPageSize = 100
+
+func (v *RootView) loadMessageHistory() {
+	page, err := v.apiClient.MessageHistory("", "", historyPageSize)
+	if err != nil {
+		app.Log("error loading message history: %s\n", err)
+		return
+	}
+	v.messageListView.AddStoredMessages(page.Messages)
+}
+
+func (v *RootView) loadAudioInputs() {
+	devices, err := v.apiClient.ListAudioInputs()
+	if err != nil {
+		app.Log("error listing audio inputs: %s\n", err)
+		return
+	}
+	app.Dispatch(func() {
+		v.audioInputView.SetDevices(devices.Devices)
+	})
+}
+
+func (v *RootView) handleAudioInputSelected(deviceID string) {
+	app.Log("audio input selected by user: %s", deviceID)
+
+	go func() {
+		if err := v.apiClient.SelectAudioInput(deviceID); err != nil {
+			app.Log("error selecting audio input: %s\n", err)
+		}
+		// refresh, so the picker shows the device we're actually using if switching failed
+		v.loadAudioInputs()
+	}()
 }
 
 func (v *RootView) OnDismount(ctx app.Context) {
@@ -70,19 +125,102 @@ func (v *RootView) handleRemoteEvent(evt *types.Event) {
 
 	switch e := evt.Evt.(type) {
 	case *types.Event_MessageReceived:
-		v.addMessage(e.MessageReceived.Message)
+		v.addMessage(e.MessageReceived.Message, e.MessageReceived.Verified)
 	case *types.Event_MessageSent:
-		v.addMessage(e.MessageSent.Message)
+		v.addMessage(e.MessageSent.Message, true)
+	case *types.Event_PlaybackStarted:
+		started := e.PlaybackStarted
+		v.messageListView.SetPlaybackState(started.RecordingId, PlaybackState{TotalFrames: int(started.TotalFrames)})
+	case *types.Event_PlaybackProgress:
+		progress := e.PlaybackProgress
+		v.messageListView.SetPlaybackState(progress.RecordingId, PlaybackState{
+			FrameIndex:  int(progress.FrameIndex),
+			TotalFrames: int(progress.TotalFrames),
+			Paused:      progress.Paused,
+		})
+	case *types.Event_PlaybackFinished:
+		v.messageListView.ClearPlaybackState(e.PlaybackFinished.RecordingId)
+	case *types.Event_RecordingStopped:
+		v.recordingStopped(e.RecordingStopped.RecordingId)
+	case *types.Event_LiveVoiceStarted:
+		v.talkStarted(e.LiveVoiceStarted.TalkId, e.LiveVoiceStarted.Talker)
+	case *types.Event_LiveVoiceEnded:
+		v.talkEnded(e.LiveVoiceEnded.TalkId)
+	case *types.Event_ReceiptReceived:
+		v.messageListView.AddReceipt(e.ReceiptReceived.Receipt)
+	case *types.Event_OutboxStatusChanged:
+		v.messageListView.SetOutboxStatus(e.OutboxStatusChanged)
 	case *types.Event_UserJoined:
 		v.userJoined(e.UserJoined.User)
+	case *types.Event_UserLeft:
+		v.userLeft(e.UserLeft.User)
+	case *types.Event_ConnectionStateChanged:
+		v.peerListView.SetConnectionState(e.ConnectionStateChanged.User, e.ConnectionStateChanged.State)
+	case *types.Event_RelayAddressAcquired:
+		v.networkStatusView.AddRelayAddr(e.RelayAddressAcquired.RelayAddr)
+	case *types.Event_NatTypeDetected:
+		v.networkStatusView.SetNATType(e.NatTypeDetected)
+	case *types.Event_PeerDiscovered:
+		v.peerListView.AddNearbyUser(e.PeerDiscovered.User)
+	case *types.Event_AuthenticationFailed:
+		app.Log("dropped data from peer %s: %s", e.AuthenticationFailed.SenderPeerId, e.AuthenticationFailed.Reason)
+	case *types.Event_RoomMemberFound:
+		app.Log("found peer %s in room %s", e.RoomMemberFound.User.PeerId, e.RoomMemberFound.RoomName)
 	}
 }
 
+// handleAttachmentClick plays an audio attachment, or pauses / resumes it if it's already playing.
 func (v *RootView) handleAttachmentClick(a *types.Attachment) {
 	app.Log("attachment clicked %v", a)
-	if err := v.apiClient.PlayAudioRecording(a.Id); err != nil {
-		app.Log("error playing attachment: %s\n", err)
+	state, playing := v.messageListView.PlaybackState(a.Id)
+
+	go func() {
+		var err error
+		switch {
+		case !playing:
+			// the user wants to hear this one now, not after whatever's playing
+			err = v.apiClient.PlayAudio(&types.PlayAudioRecordingRequest{RecordingId: a.Id, Interrupt: true})
+		case state.Paused:
+			err = v.apiClient.ResumePlayback(a.Id)
+		default:
+			err = v.apiClient.PausePlayback(a.Id)
+		}
+		if err != nil {
+			app.Log("error playing attachment: %s\n", err)
+		}
+	}()
+}
+
+func (v *RootView) handlePlaybackSeek(recordingID string, frameIndex int) {
+	go func() {
+		if err := v.apiClient.SeekPlayback(recordingID, frameIndex); err != nil {
+			app.Log("error seeking: %s\n", err)
+		}
+	}()
+}
+
+func (v *RootView) handlePlaybackStop(recordingID string) {
+	go func() {
+		if err := v.apiClient.StopPlayback(recordingID); err != nil {
+			app.Log("error stopping playback: %s\n", err)
+		}
+	}()
+}
+
+func (v *RootView) attachmentDownloadURL(a *types.Attachment) string {
+	if a.GetAudio() == nil {
+		return ""
 	}
+	return v.apiClient.RecordingDownloadURL(a.Id)
+}
+
+func (v *RootView) handleMessageRead(msg *types.Message) {
+	// called while mounting the message view, so don't block the UI goroutine
+	go func() {
+		if err := v.apiClient.MarkMessageRead(msg); err != nil {
+			app.Log("error marking message as read: %s\n", err)
+		}
+	}()
 }
 
 func (v *RootView) handleNewPeerRequested(peerIdOrAddr string) {
@@ -93,13 +231,26 @@ func (v *RootView) handleNewPeerRequested(peerIdOrAddr string) {
 	}
 }
 
+func (v *RootView) handleJoinRoomRequested(roomName string) {
+	app.Log("join room requested by user: %s", roomName)
+
+	if err := v.apiClient.JoinRoom(roomName); err != nil {
+		app.Log("error joining room: %s\n", err)
+	}
+}
+
 func (v *RootView) userJoined(info *types.UserInfo) {
 	app.Log("got user joined event: %v", info)
 	v.peerListView.AddUser(info)
 }
 
-func (v *RootView) addMessage(msg *types.Message) {
-	v.messageListView.AddMessage(msg)
+func (v *RootView) userLeft(info *types.UserInfo) {
+	app.Log("got user left event: %v", info)
+	v.peerListView.RemoveUser(info)
+}
+
+func (v *RootView) addMessage(msg *types.Message, verified bool) {
+	v.messageListView.AddMessage(msg, verified)
 }
 
 func (v *RootView) sendMessage(msg *types.Message) error {
@@ -123,6 +274,10 @@ func (v *RootView) Render() app.UI {
 	if v.isRecording {
 		btnClass = "state-recording"
 	}
+	talkBtnClass := "state-not-talking"
+	if v.isTalking {
+		talkBtnClass = "state-talking"
+	}
 
 	return app.Div().Class("root-view").Body(
 
@@ -130,16 +285,37 @@ func (v *RootView) Render() app.UI {
 
 			v.messageListView,
 
+			v.renderTalkers(),
+
 			app.Div().Body(
 				MessageInput(v.textMessageEntered),
 				app.Button().
 					Class("recording-button").
 					Class(btnClass).
 					OnClick(v.onClick).
-					Body(Icon("fas fa-microphone").Color("white"))),
+					Body(Icon("fas fa-microphone").Color("white")),
+				app.Button().
+					Class("talk-button").
+					Class(talkBtnClass).
+					Title("Hold to talk").
+					OnMouseDown(v.onTalkPressed).
+					OnMouseUp(v.onTalkReleased).
+					OnMouseOut(v.onTalkReleased).
+					Body(Icon("fas fa-broadcast-tower").Color("white")),
+				app.Label().Class("talk-save-option").Body(
+					app.Input().
+						Type("checkbox").
+						Checked(v.saveTalks).
+						OnChange(v.onSaveTalksChanged),
+					app.Text("also send as a voice message"),
+				)),
 		),
 
-		v.peerListView,
+		app.Div().Class("sidebar").Body(
+			v.peerListView,
+			v.audioInputView,
+			v.networkStatusView,
+		),
 	)
 }
 
@@ -148,6 +324,10 @@ func (v *RootView) onClick(ctx app.Context, e app.Event) {
 
 	recID := ""
 
+	if v.isTalking {
+		return
+	}
+
 	if !v.isRecording {
 		resp, err := v.apiClient.StartAudioRecording()
 		if err != nil {
@@ -172,12 +352,34 @@ func (v *RootView) onClick(ctx app.Context, e app.Event) {
 
 	v.Update()
 
-	// TODO: move playback & send code elsewhere?
 	if recID != "" {
-		go v.apiClient.PlayAudioRecording(recID)
-		if err := v.sendAudioMessage(recID); err != nil {
-			app.Log("error sending audio message: %s", err)
+		v.recordingFinished(recID)
+	}
+}
+
+// recordingStopped is called when the backend stops a recording because it hit the max duration.
+func (v *RootView) recordingStopped(recordingID string) {
+	app.Dispatch(func() {
+		if v.isTalking && v.currentTalkID == recordingID {
+			v.talkFinished()
+			return
 		}
+		if !v.isRecording || v.currentRecordingID != recordingID {
+			return
+		}
+		v.isRecording = false
+		v.currentRecordingID = ""
+		v.Update()
+
+		v.recordingFinished(recordingID)
+	})
+}
+
+func (v *RootView) recordingFinished(recID string) {
+	// TODO: move playback & send code elsewhere?
+	go v.apiClient.PlayAudioRecording(recID)
+	if err := v.sendAudioMessage(recID); err != nil {
+		app.Log("error sending audio message: %s", err)
 	}
 }
 
@@ -198,3 +400,92 @@ func (v *RootView) sendAudioMessage(recordingID string) error {
 
 	return v.sendMessage(&msg)
 }
+
+func (v *RootView) onTalkPressed(ctx app.Context, e app.Event) {
+	if v.isRecording || v.isTalking {
+		return
+	}
+
+	resp, err := v.apiClient.StartTalking(v.saveTalks)
+	if err != nil {
+		app.Log("error starting to talk: %s", err)
+		return
+	}
+	v.isTalking = true
+	v.currentTalkID = resp.RecordingId
+	v.Update()
+}
+
+func (v *RootView) onTalkReleased(ctx app.Context, e app.Event) {
+	if !v.isTalking {
+		return
+	}
+
+	if err := v.apiClient.EndAudioRecording(v.currentTalkID); err != nil {
+		app.Log("error stopping talk: %s", err)
+	}
+	v.talkFinished()
+}
+
+// talkFinished resets the push-to-talk state once the backend has stopped streaming,
+// and sends the talk as a voice message if the user asked us to keep it.
+func (v *RootView) talkFinished() {
+	talkID := v.currentTalkID
+	saved := v.saveTalks
+	v.isTalking = false
+	v.currentTalkID = ""
+	v.Update()
+
+	if saved {
+		// everyone already heard it live, so unlike a recording we don't play it back
+		if err := v.sendAudioMessage(talkID); err != nil {
+			app.Log("error sending audio message: %s", err)
+		}
+	}
+}
+
+func (v *RootView) onSaveTalksChanged(ctx app.Context, e app.Event) {
+	v.saveTalks = ctx.JSSrc.Get("checked").Bool()
+	v.Update()
+}
+
+func (v *RootView) talkStarted(talkID string, talker *types.UserInfo) {
+	app.Dispatch(func() {
+		v.talkers[talkID] = talker
+		v.Update()
+	})
+}
+
+func (v *RootView) talkEnded(talkID string) {
+	app.Dispatch(func() {
+		delete(v.talkers, talkID)
+		v.Update()
+	})
+}
+
+func (v *RootView) renderTalkers() app.UI {
+	if len(v.talkers) == 0 {
+		return app.Div().Class("talkers-view").Class("empty")
+	}
+
+	// sorted, so the list doesn't shuffle around on every render
+	ids := make([]string, 0, len(v.talkers))
+	for id := range v.talkers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var items []app.UI
+	for _, id := range ids {
+		talker := v.talkers[id]
+		name := talker.GetNickname()
+		if name == "" {
+			name = talker.GetPeerId()
+		}
+		items = append(items, app.Div().Class("talker").Body(
+			Icon("fas fa-volume-up"),
+			app.Text(fmt.Sprintf(" %s is talking", name)),
+		))
+	}
+	return app.Div().Class("talkers-view").Body(items...)
+}
@@ -7,7 +7,7 @@ require (
 	github.com/go-resty/resty/v2 v2.4.0
 	github.com/gogo/protobuf v1.3.2
 	github.com/google/uuid v1.2.0
-	github.com/hajimehoshi/oto v0.7.1
+	github.com/ipfs/go-cid v0.0.7
 	github.com/ipfs/go-log v1.0.4
 	github.com/libp2p/go-libp2p v0.13.1-0.20210202115131-837edb0b0bd5
 	github.com/libp2p/go-libp2p-core v0.8.1-0.20210202093214-7116e2835272
@@ -17,6 +17,7 @@ require (
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/modern-go/reflect2 v1.0.1 // indirect
 	github.com/multiformats/go-multiaddr v0.3.1
+	github.com/multiformats/go-multihash v0.0.14
 	github.com/pion/ice/v2 v2.0.15 // indirect
 	github.com/pion/mediadevices v0.1.14
 	github.com/pion/webrtc/v3 v3.0.4 // indirect
@@ -5,48 +5,134 @@ import (
 	"fmt"
 	"github.com/ipfs/go-log"
 	"github.com/webview/webview"
+	"github.com/yusefnapora/party-line/p2p"
 	"os"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
 	log.SetLogLevel("p2p/hole-punch", "INFO")
 
-	osUser, found := os.LookupEnv("USER")
-	if !found {
-		osUser = "unknown user"
-	}
+	cfg := DefaultConfig()
 
-	port := flag.Int("ui-port", 7777, "port number for backend / frontend comms")
+	configPath := flag.String("config", "", "path to JSON config file (default is config.json in data-dir)")
+	port := flag.Int("ui-port", cfg.UIPort, "port number for backend / frontend comms")
 	headless := flag.Bool("headless", false, "don't open a webview on start")
-	nick := flag.String("nick", osUser, "nickname / display name")
+	nick := flag.String("nick", cfg.UserNick, "nickname / display name")
 	noLAN := flag.Bool("no-lan", false, "ignore local (LAN) addrs for peers")
+	dataDir := flag.String("data-dir", cfg.DataDir, "directory for persistent app data")
+	identityPath := flag.String("identity", "", "path to libp2p identity key (default is identity.key in data-dir)")
+	exportIdentity := flag.String("export-identity", "", "copy the identity key to the given path and exit")
+	importIdentity := flag.String("import-identity", "", "replace the identity key with the one at the given path and exit")
+	noDHT := flag.Bool("no-dht", false, "don't use the DHT for peer routing")
+	noMDNS := flag.Bool("no-mdns", false, "don't look for peers on the local network")
+	lanAutoConnect := flag.Bool("lan-auto-connect", false, "connect to peers found on the local network automatically")
+	audioQuotaMB := flag.Int("audio-quota-mb", cfg.AudioQuotaMB, "disk space for saved recordings, in megabytes (0 for no limit)")
+	maxRecordings := flag.Int("max-recordings", cfg.MaxRecordings, "number of saved recordings to keep (0 for no limit)")
+	maxRecordingDuration := flag.String("max-recording-duration", cfg.MaxRecordingDuration, "longest allowed voice message, e.g. 2m30s (0 for no limit)")
+	opusBitrate := flag.Int("opus-bitrate", cfg.Codec.Bitrate, "target bitrate for recorded audio, in bits per second (0 to let the encoder decide)")
+	opusFrameMs := flag.Int("opus-frame-ms", cfg.Codec.FrameSizeMs, "length of each recorded opus frame in ms: 10, 20, 40 or 60")
+	var relays, bootstrapPeers stringList
+	flag.Var(&relays, "relay", "circuit relay multiaddr to use instead of the defaults (may be repeated)")
+	flag.Var(&bootstrapPeers, "bootstrap", "DHT bootstrap multiaddr to use instead of the defaults (may be repeated)")
 
 	flag.Parse()
 
+	cfg.DataDir = *dataDir
+	if *configPath != "" {
+		if err := cfg.LoadFile(*configPath, true); err != nil {
+			panic(err)
+		}
+	} else if err := cfg.LoadFile(defaultConfigPath(cfg.DataDir), false); err != nil {
+		panic(err)
+	}
+
+	// flags given on the command line win over the config file
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "ui-port":
+			cfg.UIPort = *port
+		case "nick":
+			cfg.UserNick = *nick
+		case "no-lan":
+			cfg.BlockLocalDials = *noLAN
+		case "identity":
+			cfg.IdentityPath = *identityPath
+		case "no-dht":
+			cfg.DisableDHT = *noDHT
+		case "no-mdns":
+			cfg.DisableMDNS = *noMDNS
+		case "lan-auto-connect":
+			cfg.AutoConnectLAN = *lanAutoConnect
+		case "relay":
+			cfg.Relays = relays
+		case "bootstrap":
+			cfg.BootstrapPeers = bootstrapPeers
+		case "audio-quota-mb":
+			cfg.AudioQuotaMB = *audioQuotaMB
+		case "max-recordings":
+			cfg.MaxRecordings = *maxRecordings
+		case "max-recording-duration":
+			cfg.MaxRecordingDuration = *maxRecordingDuration
+		case "opus-bitrate":
+			cfg.Codec.Bitrate = *opusBitrate
+		case "opus-frame-ms":
+			cfg.Codec.FrameSizeMs = *opusFrameMs
+		}
+	})
+
+	if *exportIdentity != "" {
+		if err := p2p.ExportIdentity(cfg.identityPath(), *exportIdentity); err != nil {
+			fmt.Printf("error exporting identity: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("exported identity to %s\n", *exportIdentity)
+		return
+	}
+
+	if *importIdentity != "" {
+		if err := p2p.ImportIdentity(*importIdentity, cfg.identityPath()); err != nil {
+			fmt.Printf("error importing identity: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("imported identity from %s\n", *importIdentity)
+		return
+	}
+
 	// TODO: add "connect to peer id" box to UI. for now, we just pass pids on the command line
 	remotePeers := flag.Args()
 
-	a, err := NewApp(PartyLineAppConfig{
-		UIPort:          *port,
-		UserNick:        *nick,
-		BlockLocalDials: *noLAN,
-	})
+	a, err := NewApp(cfg)
 	if err != nil {
 		panic(err)
 	}
 	a.Start()
-	a.ConnectToPeers(remotePeers...)
+	go a.ConnectToPeers(remotePeers...)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 
 	if !*headless {
+		go func() {
+			<-sigCh
+			a.Shutdown()
+			os.Exit(0)
+		}()
+
 		debug := true
 		w := webview.New(debug)
 		defer w.Destroy()
 		w.SetTitle("Party Line")
 		w.SetSize(1200, 800, webview.HintNone)
-		w.Navigate(fmt.Sprintf("http://localhost:%d", *port))
+		w.Navigate(fmt.Sprintf("http://localhost:%d", cfg.UIPort))
 		w.Run()
+
+		// the window was closed
+		a.Shutdown()
 	} else {
-		// block forever, since the server is running in a background routine & we don't want to quit yet
-		select {}
+		// the server is running in a background routine, so wait here until we're told to quit
+		<-sigCh
+		a.Shutdown()
 	}
 }
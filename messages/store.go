@@ -0,0 +1,215 @@
+package messages
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	pbio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	"github.com/yusefnapora/party-line/types"
+)
+
+const storeFileName = "messages.db"
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+// DefaultStorePath returns the location of the message database inside the given data directory.
+func DefaultStorePath(dataDir string) string {
+	return filepath.Join(dataDir, storeFileName)
+}
+
+// Store keeps every message we send or receive, so conversations survive restarts.
+// Messages are appended to a file of length-delimited StoredMessage protobufs, and the
+// whole file is loaded into memory when the store is opened.
+type Store struct {
+	sync.RWMutex
+
+	f *os.File
+	w pbio.Writer
+
+	msgs []*types.StoredMessage
+
+	// checks a message's signature. The verified flag isn't trusted from disk, since anyone who can write
+	// the file could set it, so it's recomputed whenever a message is loaded or added.
+	verify func(msg *types.Message) error
+
+	// position in msgs, by types.MessageKey
+	index map[string]int
+}
+
+// OpenStore loads the messages saved at path, creating the file if it doesn't exist yet.
+// verify checks a message's signature, and is used to set the Verified flag of each stored message.
+func OpenStore(path string, verify func(msg *types.Message) error) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Store{
+		f:      f,
+		index:  make(map[string]int),
+		verify: verify,
+	}
+
+	end, err := s.load()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	// drop anything after the last complete record, e.g. if we crashed halfway through a write
+	if err := f.Truncate(end); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err := f.Seek(end, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	s.w = pbio.NewDelimitedWriter(f)
+
+	fmt.Printf("loaded %d messages from %s\n", len(s.msgs), path)
+	return s, nil
+}
+
+// load reads records from the start of the file, and returns the offset just past the last good one.
+func (s *Store) load() (int64, error) {
+	r := bufio.NewReader(s.f)
+	var offset int64
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			return offset, nil
+		}
+		if err != nil {
+			fmt.Printf("message store is truncated at offset %d: %s\n", offset, err)
+			return offset, nil
+		}
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			fmt.Printf("message store is truncated at offset %d: %s\n", offset, err)
+			return offset, nil
+		}
+		rec := &types.StoredMessage{}
+		if err := rec.Unmarshal(buf); err != nil {
+			return 0, fmt.Errorf("corrupt record in message store at offset %d: %w", offset, err)
+		}
+		rec.Verified = s.verified(rec.Message)
+
+		var lenBuf [binary.MaxVarintLen64]byte
+		offset += int64(binary.PutUvarint(lenBuf[:], size)) + int64(size)
+		s.append(rec)
+	}
+}
+
+// append must be called with the lock held.
+func (s *Store) append(rec *types.StoredMessage) {
+	if key := types.MessageKey(rec.Message); key != "" {
+		s.index[key] = len(s.msgs)
+	}
+	s.msgs = append(s.msgs, rec)
+}
+
+// Add saves a message. Messages we already have from the same author are ignored.
+// For messages we sent, msg should be the signed copy that went out to peers.
+func (s *Store) Add(msg *types.Message, sent bool) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if key := types.MessageKey(msg); key != "" {
+		if _, ok := s.index[key]; ok {
+			return nil
+		}
+	}
+
+	rec := &types.StoredMessage{
+		Message:      stripAttachmentContent(msg),
+		Sent:         sent,
+		StoredAtUnix: time.Now().Unix(),
+	}
+	if err := s.w.WriteMsg(rec); err != nil {
+		return err
+	}
+	// the attachment content hashes are signed, so the stripped copy verifies just like the original
+	rec.Verified = s.verified(rec.Message)
+	s.append(rec)
+	return nil
+}
+
+// Page returns up to limit messages that were stored before the message with id beforeID from the author
+// with peer id beforeAuthor, or the most recent messages if beforeID is empty.
+func (s *Store) Page(beforeAuthor string, beforeID string, limit int) (*types.MessageHistoryPage, error) {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	end := len(s.msgs)
+	if beforeID != "" {
+		i, ok := s.index[beforeAuthor+"/"+beforeID]
+		if !ok {
+			return nil, fmt.Errorf("no message with id %s from %s", beforeID, beforeAuthor)
+		}
+		end = i
+	}
+	start := end - limit
+	if start < 0 {
+		start = 0
+	}
+
+	page := &types.MessageHistoryPage{
+		Messages: make([]*types.StoredMessage, end-start),
+		HasMore:  start > 0,
+	}
+	copy(page.Messages, s.msgs[start:end])
+	return page, nil
+}
+
+// Record saves every message received event from evtCh, until the channel is closed.
+// Messages we send are saved with Add once they're signed, since the sent events carry the unsigned original.
+func (s *Store) Record(evtCh <-chan *types.Event) {
+	for e := range evtCh {
+		if evt, ok := e.Evt.(*types.Event_MessageReceived); ok {
+			if err := s.Add(evt.MessageReceived.Message, false); err != nil {
+				fmt.Printf("error saving message: %s\n", err)
+			}
+		}
+	}
+}
+
+func (s *Store) verified(msg *types.Message) bool {
+	return s.verify != nil && s.verify(msg) == nil
+}
+
+func (s *Store) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.f.Close()
+}
+
+// stripAttachmentContent returns a copy of msg without the audio frames, which are kept in the audio store.
+func stripAttachmentContent(msg *types.Message) *types.Message {
+	msg = proto.Clone(msg).(*types.Message)
+	for _, a := range msg.Attachments {
+		if audio := a.GetAudio(); audio != nil {
+			audio.Frames = nil
+		}
+	}
+	return msg
+}
@@ -0,0 +1,43 @@
+package p2p
+
+import (
+	"fmt"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+// authenticateHello makes sure the user in a Hello is the peer on the other end of the stream.
+func authenticateHello(hello *pb.Hello, sender peer.ID) error {
+	if hello.User == nil {
+		return fmt.Errorf("hello has no user info")
+	}
+	if hello.User.PeerId != sender.Pretty() {
+		return fmt.Errorf("hello claims to be from %s, but was sent by %s", hello.User.PeerId, sender.Pretty())
+	}
+	return nil
+}
+
+// authenticateMessage makes sure a message received on a stream was written by the user we said hello to.
+// Messages from other authors are only accepted if they carry a valid signature from the author,
+// e.g. if they were relayed by the sender.
+func authenticateMessage(msg *pb.Message, sender *pb.UserInfo) error {
+	if msg.Author == nil {
+		return fmt.Errorf("message has no author")
+	}
+	if msg.Author.PeerId != sender.PeerId {
+		if err := VerifyMessage(msg); err != nil {
+			return fmt.Errorf("message claims to be from %s, but was sent by %s: %w", msg.Author.PeerId, sender.PeerId, err)
+		}
+		return nil
+	}
+	if msg.Author.Nickname != sender.Nickname {
+		return fmt.Errorf("message author nickname %q doesn't match hello nickname %q", msg.Author.Nickname, sender.Nickname)
+	}
+	return nil
+}
+
+func (p *PartyLinePeer) authenticationFailed(sender peer.ID, claimedUser *pb.UserInfo, err error) {
+	fmt.Printf("dropping data from %s: %s\n", sender.Pretty(), err)
+	p.dispatcher.AuthenticationFailed(sender.Pretty(), claimedUser, err.Error())
+}
@@ -0,0 +1,74 @@
+package p2p
+
+import (
+	"fmt"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	dht "github.com/libp2p/go-libp2p-kad-dht"
+	ma "github.com/multiformats/go-multiaddr"
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+// DefaultRelays are the circuit relay addrs we use if none are configured.
+var DefaultRelays = []string{
+	"/ip4/54.255.209.104/tcp/12001/p2p/Qma71QQyJN7Sw7gz1cgJ4C66ubHmvKqBasSegKRugM5qo6",
+	"/ip4/54.255.209.104/udp/12001/quic/p2p/Qma71QQyJN7Sw7gz1cgJ4C66ubHmvKqBasSegKRugM5qo6",
+}
+
+// DefaultBootstrapPeers returns the public libp2p DHT bootstrap addrs.
+func DefaultBootstrapPeers() []string {
+	addrs := make([]string, 0, len(dht.DefaultBootstrapPeers))
+	for _, a := range dht.DefaultBootstrapPeers {
+		addrs = append(addrs, a.String())
+	}
+	return addrs
+}
+
+// Config controls how the libp2p host is set up.
+type Config struct {
+	Identity crypto.PrivKey
+	UserNick string
+
+	// BlockLAN ignores private & loopback addrs when dialing peers.
+	BlockLAN bool
+
+	// Relays are used for circuit relay addrs. If empty, we won't try to get a relay addr.
+	Relays []peer.AddrInfo
+
+	// BootstrapPeers are used to join the DHT.
+	BootstrapPeers []peer.AddrInfo
+
+	// DisableDHT turns off the DHT entirely. Peers can then only be reached by
+	// dialing a full multiaddr.
+	DisableDHT bool
+
+	// DisableMDNS turns off discovery of party-line peers on the local network.
+	// mDNS is also skipped if BlockLAN is set.
+	DisableMDNS bool
+
+	// AutoConnectLAN connects to peers as soon as they're found via mDNS.
+	AutoConnectLAN bool
+
+	// OutboxDir is where messages for offline contacts are kept until they reconnect.
+	// If empty, queued messages are only kept in memory.
+	OutboxDir string
+
+	// OnPublish, if set, is called with each message we send once it's been signed, e.g. to save it.
+	// It runs before the message is handed to peers, so it shouldn't block for long, and mustn't modify msg.
+	OnPublish func(msg *pb.Message)
+}
+
+// ParseAddrInfos parses a list of multiaddr strings with /p2p/ components, merging
+// addrs that belong to the same peer.
+func ParseAddrInfos(addrStrs []string) ([]peer.AddrInfo, error) {
+	maddrs := make([]ma.Multiaddr, 0, len(addrStrs))
+	for _, s := range addrStrs {
+		a, err := ma.NewMultiaddr(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multiaddr %s: %w", s, err)
+		}
+		maddrs = append(maddrs, a)
+	}
+	return peer.AddrInfosFromP2pAddrs(maddrs...)
+}
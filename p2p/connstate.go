@@ -0,0 +1,153 @@
+package p2p
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+const (
+	redialBaseDelay   = time.Second
+	redialMaxDelay    = 5 * time.Minute
+	maxRedialAttempts = 10
+)
+
+// peerConn tracks the state of our party-line session with a peer.
+type peerConn struct {
+	user  *pb.UserInfo
+	state pb.ConnectionState
+
+	// we only redial peers that we dialed in the first place, so both sides don't race to reconnect
+	redial         bool
+	redialAttempts int
+}
+
+// getPeerConn must be called with connsLk held.
+func (p *PartyLinePeer) getPeerConn(pid peer.ID) *peerConn {
+	pc, ok := p.conns[pid]
+	if !ok {
+		pc = &peerConn{
+			user:  &pb.UserInfo{PeerId: pid.Pretty()},
+			state: pb.ConnectionState_DISCONNECTED,
+		}
+		p.conns[pid] = pc
+	}
+	return pc
+}
+
+func (p *PartyLinePeer) connState(pid peer.ID) pb.ConnectionState {
+	p.connsLk.Lock()
+	defer p.connsLk.Unlock()
+	return p.getPeerConn(pid).state
+}
+
+func (p *PartyLinePeer) setConnState(pid peer.ID, state pb.ConnectionState) {
+	p.connsLk.Lock()
+	pc := p.getPeerConn(pid)
+	changed := pc.state != state
+	pc.state = state
+	user := pc.user
+	p.connsLk.Unlock()
+
+	if changed {
+		fmt.Printf("connection to %s is now %s\n", pid.Pretty(), state)
+		p.dispatcher.ConnectionStateChanged(user, state)
+	}
+}
+
+// sessionEstablished is called once we've exchanged Hello messages with a peer.
+func (p *PartyLinePeer) sessionEstablished(pid peer.ID, user *pb.UserInfo, outbound bool) {
+	p.connsLk.Lock()
+	pc := p.getPeerConn(pid)
+	pc.user = user
+	pc.redial = pc.redial || outbound
+	pc.redialAttempts = 0
+	p.connsLk.Unlock()
+
+	p.outbox.addContact(user)
+	p.setConnState(pid, p.transportState(pid))
+}
+
+// sessionEnded is called when the stream for a session is closed. It returns false if the
+// stream had already been replaced by a newer one, in which case nothing changes.
+// Peers that said Goodbye left on purpose, so we won't try to redial them.
+func (p *PartyLinePeer) sessionEnded(pid peer.ID, user *pb.UserInfo, pubCh <-chan *pb.Envelope, saidGoodbye bool) bool {
+	if !p.removeFanoutListener(user.PeerId, pubCh) {
+		return false
+	}
+
+	if saidGoodbye {
+		p.connsLk.Lock()
+		p.getPeerConn(pid).redial = false
+		p.connsLk.Unlock()
+	}
+
+	p.dispatcher.PeerLeft(user)
+	p.setConnState(pid, pb.ConnectionState_DISCONNECTED)
+	p.scheduleRedial(pid)
+	return true
+}
+
+// transportState returns DIRECT if we have any direct connection to the peer, or RELAYED otherwise.
+func (p *PartyLinePeer) transportState(pid peer.ID) pb.ConnectionState {
+	for _, c := range p.host.Network().ConnsToPeer(pid) {
+		if !isRelayAddr(c.RemoteMultiaddr()) {
+			return pb.ConnectionState_DIRECT
+		}
+	}
+	return pb.ConnectionState_RELAYED
+}
+
+// refreshTransport switches an active session between RELAYED and DIRECT, e.g. after a successful hole punch.
+func (p *PartyLinePeer) refreshTransport(pid peer.ID) {
+	switch p.connState(pid) {
+	case pb.ConnectionState_RELAYED, pb.ConnectionState_DIRECT:
+		p.setConnState(pid, p.transportState(pid))
+	}
+}
+
+func (p *PartyLinePeer) scheduleRedial(pid peer.ID) {
+	if p.closing.IsSet() {
+		return
+	}
+
+	p.connsLk.Lock()
+	defer p.connsLk.Unlock()
+
+	pc := p.getPeerConn(pid)
+	if !pc.redial || pc.redialAttempts >= maxRedialAttempts {
+		return
+	}
+
+	delay := redialBaseDelay << uint(pc.redialAttempts)
+	if delay > redialMaxDelay {
+		delay = redialMaxDelay
+	}
+	pc.redialAttempts++
+
+	fmt.Printf("redialing %s in %s (attempt %d of %d)\n", pid.Pretty(), delay, pc.redialAttempts, maxRedialAttempts)
+	time.AfterFunc(delay, func() {
+		// they may have reconnected to us in the meantime
+		if p.connState(pid) != pb.ConnectionState_DISCONNECTED {
+			return
+		}
+		if err := p.ConnectToPeer(pid); err != nil {
+			fmt.Printf("error redialing %s: %s\n", pid.Pretty(), err)
+		}
+	})
+}
+
+// connNotifee keeps the RELAYED / DIRECT state up to date as libp2p connections come and go.
+func (p *PartyLinePeer) connNotifee() network.Notifiee {
+	return &network.NotifyBundle{
+		ConnectedF: func(n network.Network, c network.Conn) {
+			go p.refreshTransport(c.RemotePeer())
+		},
+		DisconnectedF: func(n network.Network, c network.Conn) {
+			go p.refreshTransport(c.RemotePeer())
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package p2p
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+// how many message ids we remember before forgetting the oldest ones
+const seenCacheSize = 4096
+
+// seenCache is a bounded set of message ids, used to drop messages we've already handled
+// when they arrive more than once (e.g. over multiple streams or after a reconnect).
+type seenCache struct {
+	lk    sync.Mutex
+	ids   map[string]struct{}
+	order []string
+	next  int
+}
+
+func newSeenCache(size int) *seenCache {
+	return &seenCache{
+		ids:   make(map[string]struct{}, size),
+		order: make([]string, size),
+	}
+}
+
+// has returns true if id is in the cache.
+func (c *seenCache) has(id string) bool {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	_, seen := c.ids[id]
+	return seen
+}
+
+// add records id as seen, and returns false if it was already in the cache.
+func (c *seenCache) add(id string) bool {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	if _, seen := c.ids[id]; seen {
+		return false
+	}
+
+	// evict the oldest id once the ring buffer wraps around
+	if old := c.order[c.next]; old != "" {
+		delete(c.ids, old)
+	}
+	c.order[c.next] = id
+	c.next = (c.next + 1) % len(c.order)
+	c.ids[id] = struct{}{}
+	return true
+}
+
+// messageKey returns the id used to de-duplicate msg. Message ids are scoped to the author's peer id (see
+// pb.MessageKey); otherwise anyone could drop someone else's message by sending one with the same id first.
+// Messages without a message id fall back to a hash of their contents.
+func messageKey(msg *pb.Message) string {
+	if key := pb.MessageKey(msg); key != "" {
+		return key
+	}
+	buf, err := msg.Marshal()
+	if err != nil {
+		return ""
+	}
+	h := sha256.Sum256(buf)
+	return "sha256:" + hex.EncodeToString(h[:])
+}
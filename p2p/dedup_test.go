@@ -0,0 +1,121 @@
+package p2p
+
+import (
+	"testing"
+
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+func TestSeenCache(t *testing.T) {
+	c := newSeenCache(2)
+	if !c.add("a") || !c.add("b") {
+		t.Fatalf("expected new ids to be added")
+	}
+	if c.add("a") || !c.has("b") {
+		t.Fatalf("expected ids to be remembered")
+	}
+
+	// the oldest id is forgotten once the cache is full
+	c.add("c")
+	if c.has("a") || !c.has("b") || !c.has("c") {
+		t.Fatalf("expected only the oldest id to be evicted")
+	}
+}
+
+func TestMessageKeyIsScopedToAuthor(t *testing.T) {
+	_, alice := testIdentity(t, "alice")
+	_, bob := testIdentity(t, "bob")
+
+	fromAlice := testMessage(alice, "m1", "hi")
+	fromBob := testMessage(bob, "m1", "hi")
+	if messageKey(fromAlice) == messageKey(fromBob) {
+		t.Fatalf("expected the same id from two authors to have different keys")
+	}
+	if messageKey(fromAlice) != messageKey(testMessage(alice, "m1", "edited")) {
+		t.Fatalf("expected the same id from the same author to have the same key")
+	}
+
+	// messages without an id fall back to their content
+	noID := testMessage(alice, "", "hi")
+	if messageKey(noID) == "" || messageKey(noID) == messageKey(testMessage(alice, "", "bye")) {
+		t.Fatalf("expected messages without ids to be keyed by their content")
+	}
+}
+
+func TestCheckIncomingDedupsByAuthor(t *testing.T) {
+	aliceKey, alice := testIdentity(t, "alice")
+	bobKey, bob := testIdentity(t, "bob")
+	p := &PartyLinePeer{seen: newSeenCache(seenCacheSize)}
+
+	fromAlice := signedTestMessage(t, aliceKey, testMessage(alice, "m1", "hi from alice"))
+	fromBob := signedTestMessage(t, bobKey, testMessage(bob, "m1", "hi from bob"))
+
+	if dup, verified := p.checkIncoming(fromAlice); dup || !verified {
+		t.Fatalf("expected alice's message to be new and verified, got dup %v verified %v", dup, verified)
+	}
+	if dup, verified := p.checkIncoming(fromBob); dup || !verified {
+		t.Fatalf("expected bob's message with the same id not to be a duplicate, got dup %v verified %v", dup, verified)
+	}
+	if dup, _ := p.checkIncoming(fromAlice); !dup {
+		t.Fatalf("expected alice's message to be a duplicate the second time")
+	}
+}
+
+func TestUnverifiedCopyDoesNotShadowVerified(t *testing.T) {
+	aliceKey, alice := testIdentity(t, "alice")
+	p := &PartyLinePeer{seen: newSeenCache(seenCacheSize)}
+
+	genuine := signedTestMessage(t, aliceKey, testMessage(alice, "m1", "the real thing"))
+	tests := []struct {
+		name   string
+		forged *pb.Message
+	}{
+		{"unsigned", testMessage(alice, "m1", "forged")},
+		{"bad signature", func() *pb.Message {
+			msg := signedTestMessage(t, aliceKey, testMessage(alice, "m1", "the real thing"))
+			msg.TextContent = "forged"
+			return msg
+		}()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if dup, verified := p.checkIncoming(tt.forged); dup || verified {
+				t.Fatalf("expected forged copy to be new and unverified, got dup %v verified %v", dup, verified)
+			}
+		})
+	}
+
+	if dup, verified := p.checkIncoming(genuine); dup || !verified {
+		t.Fatalf("expected the genuine message to get through after the forged ones, got dup %v verified %v",
+			dup, verified)
+	}
+}
+
+func TestAuthenticateMessage(t *testing.T) {
+	aliceKey, alice := testIdentity(t, "alice")
+	_, bob := testIdentity(t, "bob")
+
+	tests := []struct {
+		name   string
+		msg    *pb.Message
+		sender *pb.UserInfo
+		ok     bool
+	}{
+		{"unsigned from the author", testMessage(alice, "m1", "hi"), alice, true},
+		{"signed and relayed", signedTestMessage(t, aliceKey, testMessage(alice, "m1", "hi")), bob, true},
+		{"unsigned and relayed", testMessage(alice, "m1", "hi"), bob, false},
+		{"no author", &pb.Message{MessageId: "m1"}, alice, false},
+		{"wrong nickname", testMessage(&pb.UserInfo{PeerId: alice.PeerId, Nickname: "bob"}, "m1", "hi"), alice, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authenticateMessage(tt.msg, tt.sender)
+			if tt.ok && err != nil {
+				t.Fatalf("expected message to be accepted, got %s", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatalf("expected message to be rejected")
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	pbio "github.com/gogo/protobuf/io"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+// historyProtocolID is used to catch up on messages we missed while we weren't connected.
+// It runs on its own stream, so a slow sync doesn't hold up the chat.
+const historyProtocolID = "/hacks/party-line/history/1.0.0"
+
+const (
+	// we won't sync messages older than this
+	historyMaxAge = 24 * time.Hour
+
+	// how many recent messages we keep around to share with peers
+	historyMaxMessages = 500
+
+	historySyncTimeout = time.Minute
+
+	// a peer asks for history as soon as it has our Hello, which can be just before we've set up our side
+	// of the session, so a request waits this long for the session to show up
+	historySessionWait = 5 * time.Second
+)
+
+// messageHistory keeps the most recent signed messages, so we can send them to peers that missed them.
+// Audio frames aren't kept, since the audio store already has them; they're put back when the messages are sent.
+type messageHistory struct {
+	lk   sync.Mutex
+	msgs []*pb.Message
+}
+
+func (h *messageHistory) add(msg *pb.Message) {
+	// without an id, the other side can't tell us whether they already have it
+	if msg.MessageId == "" {
+		return
+	}
+
+	msg = withoutAttachmentContent(msg)
+	h.lk.Lock()
+	defer h.lk.Unlock()
+	h.msgs = append(h.msgs, msg)
+	if len(h.msgs) > historyMaxMessages {
+		h.msgs = h.msgs[len(h.msgs)-historyMaxMessages:]
+	}
+}
+
+// since returns the messages sent after cutoff, oldest first.
+func (h *messageHistory) since(cutoff int64) []*pb.Message {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+
+	var msgs []*pb.Message
+	for _, msg := range h.msgs {
+		if msg.SentAtTimeUnix >= cutoff {
+			msgs = append(msgs, msg)
+		}
+	}
+	return msgs
+}
+
+// syncHistory asks a peer we just said hello to for any recent messages we don't have.
+func (p *PartyLinePeer) syncHistory(pid peer.ID, remoteUser *pb.UserInfo) {
+	ctx, cancel := context.WithTimeout(context.Background(), historySyncTimeout)
+	defer cancel()
+
+	s, err := p.host.NewStream(ctx, pid, historyProtocolID)
+	if err != nil {
+		fmt.Printf("unable to sync history with %s: %s\n", pid.Pretty(), err)
+		return
+	}
+	defer s.Close()
+	if err := s.SetDeadline(time.Now().Add(historySyncTimeout)); err != nil {
+		fmt.Printf("error setting history sync deadline: %s\n", err)
+	}
+
+	cutoff := time.Now().Add(-historyMaxAge).Unix()
+	req := &pb.HistoryRequest{SinceUnix: cutoff}
+	for _, msg := range p.history.since(cutoff) {
+		req.KnownMessageIds = append(req.KnownMessageIds, msg.MessageId)
+	}
+
+	w := pbio.NewDelimitedWriter(s)
+	if err := w.WriteMsg(req); err != nil {
+		fmt.Printf("error requesting history from %s: %s\n", pid.Pretty(), err)
+		return
+	}
+	if err := s.CloseWrite(); err != nil {
+		fmt.Printf("error requesting history from %s: %s\n", pid.Pretty(), err)
+		return
+	}
+
+	r := pbio.NewDelimitedReader(s, maxMessageSize)
+	count := 0
+	for count < historyMaxMessages {
+		msg := &pb.Message{}
+		if err := r.ReadMsg(msg); err != nil {
+			if err != io.EOF {
+				fmt.Printf("error reading history from %s: %s\n", pid.Pretty(), err)
+			}
+			break
+		}
+		// most of these will have been written by someone else, so they need a valid signature
+		if err := authenticateMessage(msg, remoteUser); err != nil {
+			p.authenticationFailed(pid, msg.Author, err)
+			continue
+		}
+		p.incomingMsgCh <- msg
+		count++
+	}
+	if count > 0 {
+		fmt.Printf("got %d messages from %s while syncing history\n", count, pid.Pretty())
+	}
+}
+
+// handleHistoryStream sends a peer the messages they asked for.
+// Only peers we have a session with get history, the same as with new messages.
+func (p *PartyLinePeer) handleHistoryStream(s network.Stream) {
+	pid := s.Conn().RemotePeer()
+	if !p.waitForSession(pid, historySessionWait) {
+		fmt.Printf("ignoring history request from %s, since we don't have a session with them\n", pid.Pretty())
+		_ = s.Reset()
+		return
+	}
+	defer s.Close()
+
+	if err := s.SetDeadline(time.Now().Add(historySyncTimeout)); err != nil {
+		fmt.Printf("error setting history sync deadline: %s\n", err)
+	}
+
+	r := pbio.NewDelimitedReader(s, maxMessageSize)
+	req := &pb.HistoryRequest{}
+	if err := r.ReadMsg(req); err != nil {
+		fmt.Printf("error reading history request from %s: %s\n", pid.Pretty(), err)
+		return
+	}
+
+	known := make(map[string]struct{}, len(req.KnownMessageIds))
+	for _, id := range req.KnownMessageIds {
+		known[id] = struct{}{}
+	}
+
+	cutoff := time.Now().Add(-historyMaxAge).Unix()
+	if req.SinceUnix > cutoff {
+		cutoff = req.SinceUnix
+	}
+
+	w := pbio.NewDelimitedWriter(s)
+	for _, msg := range p.history.since(cutoff) {
+		if _, ok := known[msg.MessageId]; ok {
+			continue
+		}
+		if err := w.WriteMsg(p.restoreAttachmentContent(msg)); err != nil {
+			fmt.Printf("error sending history to %s: %s\n", pid.Pretty(), err)
+			return
+		}
+	}
+}
+
+// waitForSession returns true once we have a session with pid, or false if there's none after timeout.
+func (p *PartyLinePeer) waitForSession(pid peer.ID, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for !p.isConnected(pid.Pretty()) {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return true
+}
@@ -0,0 +1,86 @@
+package p2p
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+)
+
+const identityFileName = "identity.key"
+
+// DefaultIdentityPath returns the location of the identity key inside the given data directory.
+func DefaultIdentityPath(dataDir string) string {
+	return filepath.Join(dataDir, identityFileName)
+}
+
+// LoadOrCreateIdentity reads a libp2p private key from path. If no key exists yet, a new
+// Ed25519 key is generated and written to path, so that our peer id stays the same
+// across restarts.
+func LoadOrCreateIdentity(path string) (crypto.PrivKey, error) {
+	key, err := LoadIdentity(path)
+	if err == nil {
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	fmt.Printf("no identity found at %s, generating a new one\n", path)
+	key, _, err = crypto.GenerateKeyPair(crypto.Ed25519, -1)
+	if err != nil {
+		return nil, err
+	}
+	if err := SaveIdentity(key, path); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// LoadIdentity reads a protobuf-encoded libp2p private key from path.
+func LoadIdentity(path string) (crypto.PrivKey, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.UnmarshalPrivateKey(buf)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding identity key at %s: %w", path, err)
+	}
+	return key, nil
+}
+
+// SaveIdentity writes key to path, creating any missing parent directories.
+// The file is only readable by the current user.
+func SaveIdentity(key crypto.PrivKey, path string) error {
+	buf, err := crypto.MarshalPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, buf, 0600)
+}
+
+// ExportIdentity copies the identity key at identityPath to destPath, so it can be moved
+// to another machine and loaded there with ImportIdentity.
+func ExportIdentity(identityPath string, destPath string) error {
+	key, err := LoadIdentity(identityPath)
+	if err != nil {
+		return err
+	}
+	return SaveIdentity(key, destPath)
+}
+
+// ImportIdentity validates the key at srcPath and installs it at identityPath, replacing
+// any existing identity.
+func ImportIdentity(srcPath string, identityPath string) error {
+	key, err := LoadIdentity(srcPath)
+	if err != nil {
+		return err
+	}
+	return SaveIdentity(key, identityPath)
+}
@@ -0,0 +1,85 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/libp2p/go-libp2p/p2p/discovery"
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+// mdnsServiceTag is advertised instead of the default ipfs tag, so we only find other party-line nodes.
+const mdnsServiceTag = "_party-line-discovery._udp"
+const mdnsInterval = 10 * time.Second
+
+type lanPeer struct {
+	user  *pb.UserInfo
+	addrs []string
+}
+
+type mdnsNotifee struct {
+	p *PartyLinePeer
+}
+
+func (n *mdnsNotifee) HandlePeerFound(pi peer.AddrInfo) {
+	n.p.lanPeerFound(pi)
+}
+
+func (p *PartyLinePeer) startMDNS(ctx context.Context) error {
+	svc, err := discovery.NewMdnsService(ctx, p.host, mdnsInterval, mdnsServiceTag)
+	if err != nil {
+		return err
+	}
+	svc.RegisterNotifee(&mdnsNotifee{p: p})
+	fmt.Printf("looking for party-line peers on the local network\n")
+	return nil
+}
+
+func (p *PartyLinePeer) lanPeerFound(pi peer.AddrInfo) {
+	p.host.Peerstore().AddAddrs(pi.ID, pi.Addrs, peerstore.TempAddrTTL)
+
+	p.lanPeersLk.Lock()
+	_, seen := p.lanPeers[pi.ID]
+	if !seen {
+		addrs := make([]string, 0, len(pi.Addrs))
+		for _, a := range pi.Addrs {
+			addrs = append(addrs, a.String())
+		}
+		lp := &lanPeer{user: &pb.UserInfo{PeerId: pi.ID.Pretty()}, addrs: addrs}
+		p.lanPeers[pi.ID] = lp
+		p.dispatcher.PeerDiscovered(lp.user, lp.addrs)
+	}
+	p.lanPeersLk.Unlock()
+
+	if !seen {
+		fmt.Printf("found peer %s on the local network\n", pi.ID.Pretty())
+	}
+
+	if !p.autoConnectLAN || !p.shouldDial(pi.ID) {
+		return
+	}
+	if err := p.ConnectToPeer(pi.ID); err != nil {
+		fmt.Printf("error connecting to LAN peer %s: %s\n", pi.ID.Pretty(), err)
+	}
+}
+
+// lanPeerIdentified re-sends the PeerDiscovered event with the user's nickname once we know it.
+func (p *PartyLinePeer) lanPeerIdentified(user *pb.UserInfo) {
+	pid, err := peer.Decode(user.PeerId)
+	if err != nil {
+		return
+	}
+
+	p.lanPeersLk.Lock()
+	defer p.lanPeersLk.Unlock()
+
+	lp, ok := p.lanPeers[pid]
+	if !ok || lp.user.Nickname == user.Nickname {
+		return
+	}
+	lp.user = user
+	p.dispatcher.PeerDiscovered(lp.user, lp.addrs)
+}
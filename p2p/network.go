@@ -0,0 +1,55 @@
+package p2p
+
+import (
+	"fmt"
+
+	"github.com/libp2p/go-libp2p-core/event"
+	"github.com/libp2p/go-libp2p-core/network"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// watchNetwork reports changes to our listen addrs and NAT type as they're discovered.
+func (p *PartyLinePeer) watchNetwork(sub event.Subscription) {
+	defer sub.Close()
+
+	p2pAddr := ma.StringCast("/p2p/" + p.host.ID().Pretty())
+	announcedRelayAddrs := make(map[string]struct{})
+
+	for e := range sub.Out() {
+		switch evt := e.(type) {
+		case event.EvtLocalAddressesUpdated:
+			fmt.Println("-----------------------------------------------------------------------------------------------------------------------------------")
+			fmt.Println("server addrs are:")
+			for _, a := range evt.Current {
+				fmt.Println(a.Address.Encapsulate(p2pAddr))
+			}
+			fmt.Println("-----------------------------------------------------------------------------------------------------------------------------------")
+
+			for _, a := range evt.Current {
+				if !isRelayAddr(a.Address) {
+					continue
+				}
+				addrStr := a.Address.Encapsulate(p2pAddr).String()
+				if _, seen := announcedRelayAddrs[addrStr]; seen {
+					continue
+				}
+				announcedRelayAddrs[addrStr] = struct{}{}
+				p.dispatcher.RelayAddressAcquired(addrStr)
+			}
+
+		case event.EvtNATDeviceTypeChanged:
+			holePunching := evt.NatDeviceType == network.NATDeviceTypeCone
+			if holePunching {
+				fmt.Printf("\n your NAT device supports NAT traversal via hole punching for %s connections\n", evt.TransportProtocol)
+			} else {
+				fmt.Printf("\n your NAT device does NOT support NAT traversal via hole punching for %s connections\n", evt.TransportProtocol)
+			}
+			p.dispatcher.NATTypeDetected(evt.TransportProtocol.String(), evt.NatDeviceType.String(), holePunching)
+		}
+	}
+}
+
+func isRelayAddr(a ma.Multiaddr) bool {
+	_, err := a.ValueForProtocol(ma.P_CIRCUIT)
+	return err == nil
+}
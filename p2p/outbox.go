@@ -0,0 +1,255 @@
+package p2p
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+const (
+	// queued messages older than this are dropped instead of being sent
+	outboxMaxAge = 7 * 24 * time.Hour
+
+	// if more messages than this are queued for a peer, the oldest ones are dropped
+	outboxMaxMessages = 500
+
+	outboxDirName = "outbox"
+	outboxFileExt = ".outbox"
+)
+
+// DefaultOutboxDir returns the location of the outbox inside the given data directory.
+func DefaultOutboxDir(dataDir string) string {
+	return filepath.Join(dataDir, outboxDirName)
+}
+
+// outbox holds messages for known contacts while they're offline, so they can be sent
+// once the contact connects again. Entries stay queued until the contact sends a delivery receipt.
+// Each contact's queue is saved to its own file in dir, so contacts & queued messages survive restarts.
+type outbox struct {
+	lk     sync.Mutex
+	dir    string
+	queues map[string]*pb.OutboxQueue
+}
+
+// newOutbox loads any saved queues from dir. If dir is empty, nothing is saved to disk.
+func newOutbox(dir string) (*outbox, error) {
+	o := &outbox{
+		dir:    dir,
+		queues: make(map[string]*pb.OutboxQueue),
+	}
+	if dir == "" {
+		return o, nil
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), outboxFileExt) {
+			continue
+		}
+		buf, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		q := &pb.OutboxQueue{}
+		if err := q.Unmarshal(buf); err != nil {
+			fmt.Printf("ignoring unreadable outbox file %s: %s\n", f.Name(), err)
+			continue
+		}
+		if q.User == nil || q.User.PeerId == "" {
+			continue
+		}
+		o.queues[q.User.PeerId] = q
+	}
+	return o, nil
+}
+
+// addContact starts queueing messages for user whenever they're offline.
+func (o *outbox) addContact(user *pb.UserInfo) {
+	o.lk.Lock()
+	defer o.lk.Unlock()
+
+	q, ok := o.queues[user.PeerId]
+	if ok && q.User.Nickname == user.Nickname {
+		return
+	}
+	if !ok {
+		q = &pb.OutboxQueue{}
+		o.queues[user.PeerId] = q
+	}
+	q.User = user
+	o.save(q)
+}
+
+// contacts returns the users we queue messages for.
+func (o *outbox) contacts() []*pb.UserInfo {
+	o.lk.Lock()
+	defer o.lk.Unlock()
+
+	users := make([]*pb.UserInfo, 0, len(o.queues))
+	for _, q := range o.queues {
+		users = append(users, q.User)
+	}
+	return users
+}
+
+// enqueue adds msg to the queue for peerID, and returns any entries that were dropped to make room.
+// msg should be stripped of its audio frames with withoutAttachmentContent, or every queue would hold
+// (and rewrite to disk) its own copy of the audio.
+func (o *outbox) enqueue(peerID string, msg *pb.Message) []*pb.OutboxEntry {
+	o.lk.Lock()
+	defer o.lk.Unlock()
+
+	q, ok := o.queues[peerID]
+	if !ok {
+		return nil
+	}
+	q.Entries = append(q.Entries, &pb.OutboxEntry{Message: msg, QueuedAtUnix: time.Now().Unix()})
+
+	dropped := o.prune(q)
+	if len(q.Entries) > outboxMaxMessages {
+		n := len(q.Entries) - outboxMaxMessages
+		dropped = append(dropped, q.Entries[:n]...)
+		q.Entries = q.Entries[n:]
+	}
+	o.save(q)
+	return dropped
+}
+
+// pending returns the queued messages for peerID, along with any entries that expired.
+func (o *outbox) pending(peerID string) (entries []*pb.OutboxEntry, expired []*pb.OutboxEntry) {
+	o.lk.Lock()
+	defer o.lk.Unlock()
+
+	q, ok := o.queues[peerID]
+	if !ok {
+		return nil, nil
+	}
+	expired = o.prune(q)
+	if len(expired) > 0 {
+		o.save(q)
+	}
+	entries = make([]*pb.OutboxEntry, len(q.Entries))
+	copy(entries, q.Entries)
+	return entries, expired
+}
+
+// remove drops the message with the given id from peerID's queue. Returns false if it wasn't queued.
+func (o *outbox) remove(peerID string, messageID string) bool {
+	o.lk.Lock()
+	defer o.lk.Unlock()
+
+	q, ok := o.queues[peerID]
+	if !ok {
+		return false
+	}
+	for i, e := range q.Entries {
+		if e.Message.GetMessageId() == messageID {
+			q.Entries = append(q.Entries[:i], q.Entries[i+1:]...)
+			o.save(q)
+			return true
+		}
+	}
+	return false
+}
+
+// prune removes entries older than outboxMaxAge and returns them. Must be called with lk held.
+func (o *outbox) prune(q *pb.OutboxQueue) []*pb.OutboxEntry {
+	cutoff := time.Now().Add(-outboxMaxAge).Unix()
+	var expired []*pb.OutboxEntry
+	kept := q.Entries[:0]
+	for _, e := range q.Entries {
+		if e.QueuedAtUnix < cutoff {
+			expired = append(expired, e)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+	q.Entries = kept
+	return expired
+}
+
+// save writes a queue to disk, via a temp file so we never leave a half-written queue behind.
+// Must be called with lk held.
+func (o *outbox) save(q *pb.OutboxQueue) {
+	if o.dir == "" {
+		return
+	}
+
+	buf, err := q.Marshal()
+	if err != nil {
+		fmt.Printf("error encoding outbox for %s: %s\n", q.User.PeerId, err)
+		return
+	}
+	path := filepath.Join(o.dir, q.User.PeerId+outboxFileExt)
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf, 0600); err != nil {
+		fmt.Printf("error saving outbox for %s: %s\n", q.User.PeerId, err)
+		return
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		fmt.Printf("error saving outbox for %s: %s\n", q.User.PeerId, err)
+	}
+}
+
+// queueForOfflineContacts adds msg to the outbox of every contact that isn't in sent.
+// The audio is left out, and put back from the audio store when the message is sent.
+func (p *PartyLinePeer) queueForOfflineContacts(msg *pb.Message, sent map[string]struct{}) {
+	stripped := withoutAttachmentContent(msg)
+	for _, user := range p.outbox.contacts() {
+		if _, ok := sent[user.PeerId]; ok {
+			continue
+		}
+		dropped := p.outbox.enqueue(user.PeerId, stripped)
+		p.dispatcher.OutboxStatusChanged(msg.MessageId, user, pb.OutboxStatus_OUTBOX_QUEUED)
+		p.outboxExpired(user, dropped)
+
+		// if they connected after msg was sent out, their outbox may already have been drained without it.
+		// Sending it now may mean they get it twice, but they drop the duplicate and the receipt
+		// takes it out of the outbox either way.
+		p.sendToPeer(user.PeerId, &pb.Envelope{Payload: &pb.Envelope_Message{Message: msg}})
+	}
+}
+
+// drainOutbox sends everything queued for a peer that just connected.
+func (p *PartyLinePeer) drainOutbox(user *pb.UserInfo) {
+	entries, expired := p.outbox.pending(user.PeerId)
+	p.outboxExpired(user, expired)
+	if len(entries) == 0 {
+		return
+	}
+
+	fmt.Printf("sending %d queued messages to %s\n", len(entries), user.PeerId)
+	for _, e := range entries {
+		env := &pb.Envelope{Payload: &pb.Envelope_Message{Message: p.restoreAttachmentContent(e.Message)}}
+		if !p.sendToPeer(user.PeerId, env) {
+			// the rest stay queued until they reconnect
+			return
+		}
+	}
+}
+
+// outboxDelivered is called when we get a receipt, in case the message was waiting in the outbox.
+func (p *PartyLinePeer) outboxDelivered(recipient *pb.UserInfo, messageID string) {
+	if p.outbox.remove(recipient.PeerId, messageID) {
+		p.dispatcher.OutboxStatusChanged(messageID, recipient, pb.OutboxStatus_OUTBOX_DELIVERED)
+	}
+}
+
+func (p *PartyLinePeer) outboxExpired(user *pb.UserInfo, entries []*pb.OutboxEntry) {
+	for _, e := range entries {
+		fmt.Printf("dropping queued message %s for %s\n", e.Message.GetMessageId(), user.PeerId)
+		p.dispatcher.OutboxStatusChanged(e.Message.GetMessageId(), user, pb.OutboxStatus_OUTBOX_EXPIRED)
+	}
+}
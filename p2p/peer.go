@@ -1,12 +1,13 @@
 package p2p
 
 import (
+	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/connmgr"
 	"github.com/libp2p/go-libp2p-core/control"
+	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/event"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/network"
@@ -25,13 +26,20 @@ import (
 	"time"
 
 	pbio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tevino/abool"
 )
 
-const protocolID = "/hacks/party-line"
+// after the Hello exchange, everything on a party-line stream is wrapped in an Envelope.
+const protocolID = "/hacks/party-line/2.0.0"
 const maxMessageSize = 1 << 20
 
 type PartyLinePeer struct {
 	host host.Host
+	dht  *dht.IpfsDHT
+
+	// used to sign outgoing messages
+	privKey crypto.PrivKey
 
 	localUser  *pb.UserInfo
 	dispatcher *api.Dispatcher
@@ -40,120 +48,181 @@ type PartyLinePeer struct {
 	// the dispatcher pushes messages here to send out via libp2p
 	publishCh <-chan *pb.Message
 
+	// called with each message we send, as peers will receive it
+	onPublish func(msg *pb.Message)
+
+	// the recorder sends push-to-talk audio here to stream to connected peers
+	voiceCh <-chan *pb.VoiceFrame
+
 	eventCh <-chan *pb.Event
 
 	incomingMsgCh chan *pb.Message
 
+	// ids of messages we've already handled
+	seen *seenCache
+
+	// messages waiting for contacts that are offline
+	outbox *outbox
+
+	// recent messages to share with peers that missed them
+	history messageHistory
+
 	fanoutLk sync.Mutex
-	fanout   map[string]chan *pb.Message
-}
+	fanout   map[string]chan *pb.Envelope
 
-func NewPeer(dispatcher *api.Dispatcher, publishCh <-chan *pb.Message, audioStore *audio.Store, userNick string, blockLAN bool) (*PartyLinePeer, error) {
-	relayId, err := peer.Decode("Qma71QQyJN7Sw7gz1cgJ4C66ubHmvKqBasSegKRugM5qo6")
-	if err != nil {
-		return nil, err
-	}
-	relayInfo := []peer.AddrInfo{
-		{
-			ID:    relayId,
-			Addrs: []ma.Multiaddr{ma.StringCast("/ip4/54.255.209.104/tcp/12001"), ma.StringCast("/ip4/54.255.209.104/udp/12001/quic")},
-		},
-	}
+	// tracks the write loops for open streams, so we can wait for them to flush on shutdown
+	writers sync.WaitGroup
+	closing abool.AtomicBool
+
+	// peers found via mDNS
+	lanPeersLk     sync.Mutex
+	lanPeers       map[peer.ID]*lanPeer
+	autoConnectLAN bool
 
+	connsLk sync.Mutex
+	conns   map[peer.ID]*peerConn
+
+	// room name -> members we've found so far
+	roomsLk sync.Mutex
+	rooms   map[string]map[peer.ID]struct{}
+}
+
+func NewPeer(dispatcher *api.Dispatcher, publishCh <-chan *pb.Message, voiceCh <-chan *pb.VoiceFrame, audioStore *audio.Store, cfg Config) (*PartyLinePeer, error) {
 	fmt.Printf("setting up libp2p host...\n")
 
 	ctx := context.Background()
 	opts := []libp2p.Option{
-		libp2p.ForceReachabilityPrivate(), libp2p.EnableAutoRelay(),
-		libp2p.StaticRelays(relayInfo), libp2p.EnableHolePunching(),
+		libp2p.Identity(cfg.Identity),
+		libp2p.ForceReachabilityPrivate(), libp2p.EnableHolePunching(),
 		libp2p.Transport(tcp.NewTCPTransport),
 		//libp2p.Transport(quic.NewTransport),
 		libp2p.ListenAddrs(ma.StringCast("/ip4/0.0.0.0/tcp/0"), ma.StringCast("/ip4/0.0.0.0/udp/0/quic")),
 	}
 
-	if blockLAN {
+	if len(cfg.Relays) > 0 {
+		opts = append(opts, libp2p.EnableAutoRelay(), libp2p.StaticRelays(cfg.Relays))
+	}
+
+	if cfg.BlockLAN {
 		opts = append(opts, libp2p.ConnectionGater(&gater{}))
 	}
 
+	ob, err := newOutbox(cfg.OutboxDir)
+	if err != nil {
+		return nil, fmt.Errorf("error loading outbox: %w", err)
+	}
+
 	h, err := libp2p.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
 
 	peer := &PartyLinePeer{
-		publishCh:     publishCh,
-		dispatcher:    dispatcher,
-		audioStore:    audioStore,
-		fanout:        make(map[string]chan *pb.Message),
-		incomingMsgCh: make(chan *pb.Message, 1024),
+		privKey:        cfg.Identity,
+		publishCh:      publishCh,
+		onPublish:      cfg.OnPublish,
+		voiceCh:        voiceCh,
+		dispatcher:     dispatcher,
+		audioStore:     audioStore,
+		fanout:         make(map[string]chan *pb.Envelope),
+		incomingMsgCh:  make(chan *pb.Message, 1024),
+		seen:           newSeenCache(seenCacheSize),
+		outbox:         ob,
+		lanPeers:       make(map[peer.ID]*lanPeer),
+		autoConnectLAN: cfg.AutoConnectLAN,
+		rooms:          make(map[string]map[peer.ID]struct{}),
+		conns:          make(map[peer.ID]*peerConn),
 	}
 
 	peer.localUser = &pb.UserInfo{
 		PeerId:   h.ID().Pretty(),
-		Nickname: userNick,
+		Nickname: cfg.UserNick,
+	}
+
+	if cfg.DisableDHT {
+		peer.host = h
+	} else {
+		// bootstrap with dht so we can connect to more peers and discover our own addresses.
+		d, err := dht.New(ctx, h, dht.Mode(dht.ModeClient), dht.BootstrapPeers(cfg.BootstrapPeers...))
+		if err != nil {
+			return nil, err
+		}
+		d.Bootstrap(ctx)
+
+		peer.dht = d
+		peer.host = routedhost.Wrap(h, d)
 	}
 
 	h.SetStreamHandler(protocolID, peer.handleIncomingStream)
+	h.SetStreamHandler(historyProtocolID, peer.handleHistoryStream)
+	h.SetStreamHandler(voiceProtocolID, peer.handleVoiceStream)
+	h.Network().Notify(peer.connNotifee())
 
 	peer.eventCh = dispatcher.AddListener(fmt.Sprintf("peer-listener-%s", h.ID().Pretty()))
 
 	go peer.fanoutLoop()
 	go peer.incomingMsgLoop()
 	go peer.eventLoop()
+	go peer.voiceLoop()
 
-	sub, err := h.EventBus().Subscribe(new(event.EvtNATDeviceTypeChanged))
-	if err != nil {
-		panic(err)
-	}
-
-	// bootstrap with dht so we can connect to more peers and discover our own addresses.
-	d, err := dht.New(ctx, h, dht.Mode(dht.ModeClient), dht.BootstrapPeers(dht.GetDefaultBootstrapPeerAddrInfos()...))
+	sub, err := h.EventBus().Subscribe([]interface{}{new(event.EvtLocalAddressesUpdated), new(event.EvtNATDeviceTypeChanged)})
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	d.Bootstrap(ctx)
-
-	peer.host = routedhost.Wrap(h, d)
 
-	// wait till we have a relay addrs
-LOOP:
-	for {
-		time.Sleep(5 * time.Second)
-		addrs := h.Addrs()
-		for _, a := range addrs {
-			if _, err := a.ValueForProtocol(ma.P_CIRCUIT); err == nil {
-				break LOOP
-			}
-		}
+	// relay reservations, NAT type detection & address discovery all happen in the background,
+	// so we can accept connections and serve the UI right away.
+	go peer.watchNetwork(sub)
+
+	if cfg.DisableMDNS {
+		fmt.Printf("local peer discovery is disabled\n")
+	} else if cfg.BlockLAN {
+		// the gater would refuse to dial anything mDNS finds
+		fmt.Printf("local peer discovery is disabled, since LAN addrs are blocked\n")
+	} else if err := peer.startMDNS(ctx); err != nil {
+		fmt.Printf("error starting local peer discovery: %s\n", err)
 	}
 
 	fmt.Println("\n server peer id is: ", h.ID().Pretty())
-	fmt.Println("-----------------------------------------------------------------------------------------------------------------------------------")
-	fmt.Println("server addrs are:")
+	fmt.Println("accepting connections now")
+
+	return peer, nil
+}
 
-	p2pAddr := ma.StringCast("/p2p/" + h.ID().Pretty())
-	for _, a := range h.Addrs() {
-		fmt.Println(a.Encapsulate(p2pAddr))
+// Close says Goodbye on every open stream, waits up to timeout for queued messages to be written,
+// then shuts down the libp2p host.
+func (p *PartyLinePeer) Close(timeout time.Duration) error {
+	if !p.closing.SetToIf(false, true) {
+		return nil
 	}
-	fmt.Println("-----------------------------------------------------------------------------------------------------------------------------------")
+	fmt.Printf("saying goodbye to connected peers\n")
 
-	// get NAT types for TCP & UDP
-	const numTransports = 1 // I had to disable quic for stupid compat reasons (using go 1.16 beta). set this to 2 if you re-enable
-	for i := 0; i < numTransports; i++ {
+	goodbye := &pb.Envelope{Payload: &pb.Envelope_Goodbye{Goodbye: &pb.Goodbye{User: p.localUser}}}
+	p.fanoutLk.Lock()
+	for pidStr, ch := range p.fanout {
 		select {
-		case ev := <-sub.Out():
-			evt := ev.(event.EvtNATDeviceTypeChanged)
-			if evt.NatDeviceType == network.NATDeviceTypeCone {
-				fmt.Printf("\n your NAT device supports NAT traversal via hole punching for %s connections", evt.TransportProtocol)
-			} else {
-				fmt.Printf("\n your NAT device does NOT support NAT traversal via hole punching for %s connections", evt.TransportProtocol)
-			}
-
-		case <-time.After(60 * time.Second):
-			panic(errors.New("could not find NAT type"))
+		case ch <- goodbye:
+		default:
+			fmt.Printf("outgoing queue for %s is full, not saying goodbye\n", pidStr)
 		}
+		close(ch)
+		delete(p.fanout, pidStr)
+	}
+	p.fanoutLk.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.writers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		fmt.Printf("timed out waiting for outgoing messages to be sent\n")
 	}
-	fmt.Println("\n------------------------------------------------------------------------------------------------------------------------------------")
-	fmt.Println("accepting connections now")
 
-	return peer, nil
+	return p.host.Close()
 }
 
 func (p *PartyLinePeer) PeerID() peer.ID {
@@ -161,20 +230,29 @@ func (p *PartyLinePeer) PeerID() peer.ID {
 }
 
 func (p *PartyLinePeer) ConnectToPeer(pid peer.ID) error {
+	if p.connState(pid) == pb.ConnectionState_DISCONNECTED {
+		p.setConnState(pid, pb.ConnectionState_CONNECTING)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
 	defer cancel()
 	s, err := p.host.NewStream(ctx, pid, protocolID)
 	if err != nil {
+		if p.connState(pid) == pb.ConnectionState_CONNECTING {
+			p.setConnState(pid, pb.ConnectionState_DISCONNECTED)
+			p.scheduleRedial(pid)
+		}
 		return err
 	}
 
-	p.handleStream(s, false)
+	// handleStream runs for as long as the stream is open, so don't block the caller on it
+	go p.handleStream(s, false)
 	return nil
 }
 
 func (p *PartyLinePeer) ConnectToPeerStr(peerStr string) error {
 	fmt.Printf("ConnectToPeerStr: %s\n", peerStr)
-	if strings.Contains("/p2p/", peerStr) {
+	if strings.Contains(peerStr, "/p2p/") {
 		maddr, err := ma.NewMultiaddr(peerStr)
 		if err != nil {
 			return err
@@ -210,7 +288,8 @@ func (p *PartyLinePeer) handleIncomingStream(s network.Stream) {
 }
 
 func (p *PartyLinePeer) handleStream(s network.Stream, inbound bool) {
-	fmt.Printf("new stream with peer %s via %v\n", s.Conn().RemotePeer().Pretty(), s.Conn().RemoteMultiaddr())
+	pid := s.Conn().RemotePeer()
+	fmt.Printf("new stream with peer %s via %v\n", pid.Pretty(), s.Conn().RemoteMultiaddr())
 
 	r := pbio.NewDelimitedReader(s, maxMessageSize)
 	w := pbio.NewDelimitedWriter(s)
@@ -218,11 +297,16 @@ func (p *PartyLinePeer) handleStream(s network.Stream, inbound bool) {
 	var remoteUser *pb.UserInfo
 	var err error
 
+	if p.connState(pid) != pb.ConnectionState_RELAYED && p.connState(pid) != pb.ConnectionState_DIRECT {
+		p.setConnState(pid, pb.ConnectionState_CONNECTED)
+	}
+
 	// inbound conns say hello first
 	if inbound {
-		_, remoteUser, err = p.readHello(r)
+		_, remoteUser, err = p.readHello(r, pid)
 		if err != nil {
 			fmt.Printf("error reading hello msg: %s\n", err)
+			p.helloFailed(s)
 			return
 		}
 
@@ -234,36 +318,65 @@ func (p *PartyLinePeer) handleStream(s network.Stream, inbound bool) {
 			fmt.Printf("error saying hello: %s\n", err)
 		}
 
-		_, remoteUser, err = p.readHello(r)
+		_, remoteUser, err = p.readHello(r, pid)
 		if err != nil {
 			fmt.Printf("error reading hello msg: %s\n", err)
+			p.helloFailed(s)
 			return
 		}
 	}
 
-	// kickoff read loop in background
-	go p.readFromStream(r)
-
 	// get a new channel to receive outgoing messages on
 	pubCh := p.addFanoutListener(remoteUser.PeerId)
-
-	// push any outgoing messages to the stream
-	for msg := range pubCh {
-		//fmt.Printf("writing outgoing message to stream: %v\n", msg)
-		if err := w.WriteMsg(msg); err != nil {
+	p.sessionEstablished(pid, remoteUser, !inbound)
+
+	// send anything we queued while they were offline. this runs in the background since
+	// the write loop below is what empties pubCh
+	go p.drainOutbox(remoteUser)
+	// and catch up on anything we missed from them or their other peers
+	go p.syncHistory(pid, remoteUser)
+
+	// kickoff read loop in background. once it ends, the session is over
+	go func() {
+		saidGoodbye := p.readFromStream(r, pid, remoteUser)
+		p.sessionEnded(pid, remoteUser, pubCh, saidGoodbye)
+	}()
+
+	p.writers.Add(1)
+	defer p.writers.Done()
+
+	// push any outgoing messages to the stream, until our fanout channel is closed
+	for env := range pubCh {
+		//fmt.Printf("writing outgoing message to stream: %v\n", env)
+		if err := w.WriteMsg(env); err != nil {
 			fmt.Printf("error publishing message: %s\n", err)
 		}
 	}
+	s.Close()
+}
+
+func (p *PartyLinePeer) helloFailed(s network.Stream) {
+	s.Reset()
+	pid := s.Conn().RemotePeer()
+	if p.connState(pid) == pb.ConnectionState_CONNECTED {
+		p.setConnState(pid, pb.ConnectionState_DISCONNECTED)
+	}
 }
 
-func (p *PartyLinePeer) readHello(r pbio.Reader) (*pb.Hello, *pb.UserInfo, error) {
+func (p *PartyLinePeer) readHello(r pbio.Reader, sender peer.ID) (*pb.Hello, *pb.UserInfo, error) {
 	var hello pb.Hello
 	if err := r.ReadMsg(&hello); err != nil {
 		fmt.Printf("error reading hello message: %s\n", err)
 		return nil, nil, err
 	}
 
+	if err := authenticateHello(&hello, sender); err != nil {
+		p.authenticationFailed(sender, hello.User, err)
+		return nil, nil, err
+	}
+
 	p.dispatcher.PeerJoined(hello.User)
+	p.lanPeerIdentified(hello.User)
 
 	return &hello, hello.User, nil
 }
@@ -273,46 +386,140 @@ func (p *PartyLinePeer) sayHello(w pbio.Writer) error {
 	return w.WriteMsg(hello)
 }
 
-func (p *PartyLinePeer) readFromStream(r pbio.ReadCloser) {
+// readFromStream reads until the stream is closed. Returns true if the remote peer said Goodbye.
+// Messages that weren't written by the sender are dropped.
+func (p *PartyLinePeer) readFromStream(r pbio.ReadCloser, pid peer.ID, sender *pb.UserInfo) bool {
 	for {
-		var msg pb.Message
-		err := r.ReadMsg(&msg)
+		var env pb.Envelope
+		err := r.ReadMsg(&env)
 		if err != nil {
 			fmt.Printf("error reading protobuf from stream: %s\n", err)
 			fmt.Printf("closing stream due to error\n")
 			r.Close()
-			return
+			return false
 		}
 
-		fmt.Printf("received message from %s\n", msg.Author.Nickname)
-		p.incomingMsgCh <- &msg
+		switch payload := env.Payload.(type) {
+		case *pb.Envelope_Message:
+			msg := payload.Message
+			if err := authenticateMessage(msg, sender); err != nil {
+				p.authenticationFailed(pid, msg.Author, err)
+				continue
+			}
+			fmt.Printf("received message from %s\n", msg.Author.Nickname)
+			p.incomingMsgCh <- msg
+
+		case *pb.Envelope_Receipt:
+			if err := p.receiptReceived(payload.Receipt, sender); err != nil {
+				p.authenticationFailed(pid, payload.Receipt.Recipient, err)
+			}
+
+		case *pb.Envelope_Goodbye:
+			fmt.Printf("%s said goodbye\n", payload.Goodbye.GetUser().GetNickname())
+			r.Close()
+			return true
+
+		default:
+			fmt.Printf("ignoring unknown stream payload of type %T\n", payload)
+		}
 	}
 }
 
-func (p *PartyLinePeer) addFanoutListener(pidStr string) <-chan *pb.Message {
+func (p *PartyLinePeer) addFanoutListener(pidStr string) <-chan *pb.Envelope {
 	p.fanoutLk.Lock()
 	defer p.fanoutLk.Unlock()
 
-	ch := make(chan *pb.Message, 1024)
+	// if we already have a stream with this peer, closing its channel ends its write loop & closes the stream
+	if old, ok := p.fanout[pidStr]; ok {
+		close(old)
+	}
+
+	ch := make(chan *pb.Envelope, 1024)
 	p.fanout[pidStr] = ch
 	return ch
 }
 
-func (p *PartyLinePeer) removeFanoutListener(pidStr string) {
+// sendToPeer writes env to our stream with the given peer. Returns false if we're not connected to them,
+// or their outgoing queue is full.
+func (p *PartyLinePeer) sendToPeer(pidStr string, env *pb.Envelope) bool {
+	p.fanoutLk.Lock()
+	defer p.fanoutLk.Unlock()
+	ch, ok := p.fanout[pidStr]
+	if !ok {
+		return false
+	}
+	return trySend(pidStr, ch, env)
+}
+
+// trySend queues env on a peer's outgoing channel, or returns false if the channel is full.
+// It never blocks, since it's called with fanoutLk held and one stalled peer mustn't hold up everyone else.
+// The send has to happen under the lock, or the channel could be closed under it.
+func trySend(pidStr string, ch chan *pb.Envelope, env *pb.Envelope) bool {
+	select {
+	case ch <- env:
+		return true
+	default:
+		fmt.Printf("outgoing queue for %s is full, not sending %T\n", pidStr, env.Payload)
+		return false
+	}
+}
+
+func (p *PartyLinePeer) isConnected(pidStr string) bool {
+	p.fanoutLk.Lock()
+	defer p.fanoutLk.Unlock()
+	_, ok := p.fanout[pidStr]
+	return ok
+}
+
+// shouldDial is used when both sides are likely to discover each other at about the same time
+// (e.g. via mDNS or a room). Only the peer with the lower id dials, to avoid opening two streams.
+func (p *PartyLinePeer) shouldDial(pid peer.ID) bool {
+	return p.host.ID() < pid && !p.isConnected(pid.Pretty())
+}
+
+// removeFanoutListener removes & closes the given channel, unless it has already been replaced
+// by a newer stream with the same peer. Returns true if the channel was removed.
+func (p *PartyLinePeer) removeFanoutListener(pidStr string, ch <-chan *pb.Envelope) bool {
 	p.fanoutLk.Lock()
 	defer p.fanoutLk.Unlock()
+
+	current, ok := p.fanout[pidStr]
+	if !ok || (<-chan *pb.Envelope)(current) != ch {
+		return false
+	}
+	close(current)
 	delete(p.fanout, pidStr)
+	return true
 }
 
 func (p *PartyLinePeer) fanoutLoop() {
 	for msg := range p.publishCh {
+		// the dispatcher also hands this message to the UI, so make our own copy before filling it in
+		msg = proto.Clone(msg).(*pb.Message)
+		// so we don't show our own message again if it gets relayed back to us
+		p.seen.add(messageKey(msg))
 		p.inlineAttachmentContent(msg)
+		if err := signMessage(msg, p.privKey); err != nil {
+			fmt.Printf("error signing message: %s\n", err)
+		} else {
+			p.history.add(msg)
+		}
+		if p.onPublish != nil {
+			p.onPublish(msg)
+		}
+		env := &pb.Envelope{Payload: &pb.Envelope_Message{Message: msg}}
 
 		p.fanoutLk.Lock()
-		for _, ch := range p.fanout {
-			ch <- msg
+		sent := make(map[string]struct{}, len(p.fanout))
+		for pidStr, ch := range p.fanout {
+			// peers that are too far behind to take it get it from the outbox instead
+			if trySend(pidStr, ch, env) {
+				sent[pidStr] = struct{}{}
+			}
 		}
 		p.fanoutLk.Unlock()
+
+		p.queueForOfflineContacts(msg, sent)
 	}
 }
 
@@ -320,17 +527,22 @@ func (p *PartyLinePeer) incomingMsgLoop() {
 	for msg := range p.incomingMsgCh {
 		//fmt.Printf("received message from incoming channel %v\n", pbMsg)
 
-		for _, a := range msg.Attachments {
-			rec, err := recordingFromAttachment(a)
-			if err != nil {
-				fmt.Printf("error unpacking audio recording: %s\n", err)
-			} else {
-				fmt.Printf("adding audio recording from message to store. recording id: %s\n", rec.ID)
-				p.audioStore.AddRecording(rec)
-			}
+		dup, verified := p.checkIncoming(msg)
+		if dup {
+			fmt.Printf("dropping duplicate message %s from %s\n", msg.MessageId, msg.Author.GetPeerId())
+			// the sender only stops resending once it gets a receipt, and the first one may have been lost
+			p.sendReceipt(msg.Author, msg.MessageId, pb.ReceiptType_DELIVERED)
+			continue
+		}
+
+		if verified {
+			// only signed messages are shared with other peers, since they can't check anything else
+			p.history.add(msg)
+			p.importAttachments(msg)
 		}
 
-		p.dispatcher.ReceiveMessage(msg)
+		p.dispatcher.ReceiveMessage(msg, verified)
+		p.sendReceipt(msg.Author, msg.MessageId, pb.ReceiptType_DELIVERED)
 	}
 }
 
@@ -340,6 +552,12 @@ func (p *PartyLinePeer) eventLoop() {
 		case *pb.Event_ConnectToPeerRequested:
 			p.connectToPeerRequested(evt.ConnectToPeerRequested.Request)
 
+		case *pb.Event_JoinRoomRequested:
+			p.joinRoomRequested(evt.JoinRoomRequested.Request)
+
+		case *pb.Event_MessageReadRequested:
+			p.messageReadRequested(evt.MessageReadRequested.Request)
+
 		default:
 			fmt.Printf("peer event loop ignoring event of type %T\n", evt)
 		}
@@ -354,12 +572,50 @@ func (p *PartyLinePeer) connectToPeerRequested(req *pb.ConnectToPeerRequest) {
 	}
 }
 
+// checkIncoming returns true for dup if we've already handled msg, and otherwise whether it has a valid
+// signature from its author. Messages were authenticated as they were read, but the signature still needs
+// checking. Only verified messages are marked as seen, so a forged copy can't shadow the real one.
+func (p *PartyLinePeer) checkIncoming(msg *pb.Message) (dup bool, verified bool) {
+	key := messageKey(msg)
+	if p.seen.has(key) {
+		return true, false
+	}
+	if err := VerifyMessage(msg); err != nil {
+		fmt.Printf("unable to verify message from %s: %s\n", msg.Author.GetPeerId(), err)
+		return false, false
+	}
+	p.seen.add(key)
+	return false, true
+}
+
+// importAttachments adds the recordings attached to a verified message to the audio store.
+// Unverified messages are shown without their audio, since we can't tell who it's really from.
+func (p *PartyLinePeer) importAttachments(msg *pb.Message) {
+	for _, a := range msg.Attachments {
+		rec, err := recordingFromAttachment(a)
+		if err != nil {
+			fmt.Printf("error unpacking audio recording: %s\n", err)
+			continue
+		}
+		if len(rec.Frames) == 0 {
+			// an empty one would keep the real audio from being added if it turns up later
+			continue
+		}
+		if err := p.audioStore.ImportRecording(rec); err != nil {
+			fmt.Printf("not adding audio recording from message %s: %s\n", msg.MessageId, err)
+			continue
+		}
+		fmt.Printf("adding audio recording from message to store. recording id: %s\n", rec.ID)
+	}
+}
+
 func recordingFromAttachment(a *pb.Attachment) (*audio.Recording, error) {
 	switch aa := a.Kind.(type) {
 	case *pb.Attachment_Audio:
 		rec := audio.Recording{
-			ID:     a.Id,
-			Frames: aa.Audio.Frames,
+			ID:          a.Id,
+			Frames:      aa.Audio.Frames,
+			FrameSizeMs: int(aa.Audio.FrameSizeMs),
 		}
 		return &rec, nil
 
@@ -380,11 +636,50 @@ func (p *PartyLinePeer) inlineAttachmentContent(msg *pb.Message) {
 			}
 
 			att.Audio.Codec = "audio/opus"
+			att.Audio.FrameSizeMs = int32(recording.FrameSizeMs)
 			att.Audio.Frames = recording.Frames
 		}
 	}
 }
 
+// withoutAttachmentContent returns a copy of msg without its audio frames, for messages we hold on to for a
+// while. The content hashes are kept, so the signature stays valid and restoreAttachmentContent can put the
+// frames back from the audio store.
+func withoutAttachmentContent(msg *pb.Message) *pb.Message {
+	msg = proto.Clone(msg).(*pb.Message)
+	for _, a := range msg.Attachments {
+		if audio := a.GetAudio(); audio != nil {
+			audio.Frames = nil
+		}
+	}
+	return msg
+}
+
+// restoreAttachmentContent returns a copy of a message from withoutAttachmentContent, with the audio frames
+// filled in from the audio store. Frames are only filled in if they match the signed content hash, since the
+// store could have someone else's recording with the same id.
+func (p *PartyLinePeer) restoreAttachmentContent(msg *pb.Message) *pb.Message {
+	msg = proto.Clone(msg).(*pb.Message)
+	for _, a := range msg.Attachments {
+		audio := a.GetAudio()
+		if audio == nil || len(audio.Frames) > 0 {
+			continue
+		}
+		recording, ok := p.audioStore.GetRecording(a.Id)
+		if !ok {
+			fmt.Printf("no recording found with attachment id %s\n", a.Id)
+			continue
+		}
+		// unsigned messages have no hash to check against
+		if len(audio.ContentSha256) > 0 && !bytes.Equal(audioContentHash(recording.Frames), audio.ContentSha256) {
+			fmt.Printf("recording %s doesn't match the attachment in message %s\n", a.Id, msg.MessageId)
+			continue
+		}
+		audio.Frames = recording.Frames
+	}
+	return msg
+}
+
 var _ connmgr.ConnectionGater = (*gater)(nil)
 
 type gater struct {
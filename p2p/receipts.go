@@ -0,0 +1,42 @@
+package p2p
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+// sendReceipt tells the author of a message that we received or read it.
+// Receipts are only sent if we currently have a stream open to the author.
+func (p *PartyLinePeer) sendReceipt(author *pb.UserInfo, messageID string, receiptType pb.ReceiptType) {
+	if author == nil || messageID == "" || author.PeerId == p.localUser.PeerId {
+		return
+	}
+
+	receipt := &pb.Receipt{
+		MessageId:     messageID,
+		Recipient:     p.localUser,
+		Type:          receiptType,
+		TimestampUnix: time.Now().Unix(),
+	}
+	env := &pb.Envelope{Payload: &pb.Envelope_Receipt{Receipt: receipt}}
+	if !p.sendToPeer(author.PeerId, env) {
+		fmt.Printf("unable to send to %s, dropping %s receipt for message %s\n", author.PeerId, receiptType, messageID)
+	}
+}
+
+// receiptReceived checks that a receipt came from the recipient it names before passing it on to the UI.
+func (p *PartyLinePeer) receiptReceived(receipt *pb.Receipt, sender *pb.UserInfo) error {
+	if receipt.Recipient == nil || receipt.Recipient.PeerId != sender.PeerId {
+		return fmt.Errorf("receipt for message %s claims to be from %s, but was sent by %s",
+			receipt.MessageId, receipt.Recipient.GetPeerId(), sender.PeerId)
+	}
+	p.dispatcher.ReceiptReceived(receipt)
+	p.outboxDelivered(sender, receipt.MessageId)
+	return nil
+}
+
+func (p *PartyLinePeer) messageReadRequested(req *pb.MarkMessageReadRequest) {
+	p.sendReceipt(req.Author, req.MessageId, pb.ReceiptType_READ)
+}
@@ -0,0 +1,125 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	mh "github.com/multiformats/go-multihash"
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+const (
+	// how often we look for new members of the rooms we've joined
+	roomFindInterval = time.Minute
+
+	// how often we re-advertise ourselves as a member of a room
+	roomProvideInterval = 10 * time.Minute
+
+	roomFindTimeout    = 30 * time.Second
+	roomProvideTimeout = time.Minute
+)
+
+// roomCid turns a human-readable room name into the CID we provide on the DHT.
+func roomCid(roomName string) (cid.Cid, error) {
+	h, err := mh.Sum([]byte("party-line-room:"+roomName), mh.SHA2_256, -1)
+	if err != nil {
+		return cid.Undef, err
+	}
+	return cid.NewCidV1(cid.Raw, h), nil
+}
+
+// JoinRoom advertises us as a member of the given room on the DHT and periodically looks
+// for other members to connect to.
+func (p *PartyLinePeer) JoinRoom(roomName string) error {
+	if p.dht == nil {
+		return fmt.Errorf("can't join room %s: the DHT is disabled", roomName)
+	}
+
+	key, err := roomCid(roomName)
+	if err != nil {
+		return err
+	}
+
+	p.roomsLk.Lock()
+	defer p.roomsLk.Unlock()
+	if _, joined := p.rooms[roomName]; joined {
+		return nil
+	}
+	p.rooms[roomName] = make(map[peer.ID]struct{})
+
+	fmt.Printf("joining room %s (%s)\n", roomName, key)
+	go p.roomLoop(roomName, key)
+	return nil
+}
+
+func (p *PartyLinePeer) roomLoop(roomName string, key cid.Cid) {
+	provideTicker := time.NewTicker(roomProvideInterval)
+	defer provideTicker.Stop()
+	findTicker := time.NewTicker(roomFindInterval)
+	defer findTicker.Stop()
+
+	p.provideRoom(roomName, key)
+	p.findRoomMembers(roomName, key)
+
+	for {
+		select {
+		case <-provideTicker.C:
+			p.provideRoom(roomName, key)
+		case <-findTicker.C:
+			p.findRoomMembers(roomName, key)
+		}
+	}
+}
+
+func (p *PartyLinePeer) provideRoom(roomName string, key cid.Cid) {
+	ctx, cancel := context.WithTimeout(context.Background(), roomProvideTimeout)
+	defer cancel()
+
+	if err := p.dht.Provide(ctx, key, true); err != nil {
+		fmt.Printf("error advertising room %s: %s\n", roomName, err)
+	}
+}
+
+func (p *PartyLinePeer) findRoomMembers(roomName string, key cid.Cid) {
+	ctx, cancel := context.WithTimeout(context.Background(), roomFindTimeout)
+	defer cancel()
+
+	for pi := range p.dht.FindProvidersAsync(ctx, key, 0) {
+		if pi.ID == p.host.ID() {
+			continue
+		}
+		p.roomMemberFound(roomName, pi)
+	}
+}
+
+func (p *PartyLinePeer) roomMemberFound(roomName string, pi peer.AddrInfo) {
+	p.host.Peerstore().AddAddrs(pi.ID, pi.Addrs, peerstore.TempAddrTTL)
+
+	p.roomsLk.Lock()
+	members := p.rooms[roomName]
+	_, seen := members[pi.ID]
+	members[pi.ID] = struct{}{}
+	p.roomsLk.Unlock()
+
+	if !seen {
+		fmt.Printf("found peer %s in room %s\n", pi.ID.Pretty(), roomName)
+		p.dispatcher.RoomMemberFound(roomName, &pb.UserInfo{PeerId: pi.ID.Pretty()})
+	}
+
+	if !p.shouldDial(pi.ID) {
+		return
+	}
+	if err := p.ConnectToPeer(pi.ID); err != nil {
+		fmt.Printf("error connecting to peer %s in room %s: %s\n", pi.ID.Pretty(), roomName, err)
+	}
+}
+
+func (p *PartyLinePeer) joinRoomRequested(req *pb.JoinRoomRequest) {
+	if err := p.JoinRoom(req.RoomName); err != nil {
+		fmt.Printf("error joining room: %s\n", err)
+	}
+}
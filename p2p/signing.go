@@ -0,0 +1,129 @@
+package p2p
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+// prepended to the signed bytes, so a message signature can't be confused with any other use of the identity key
+const messageSignaturePrefix = "party-line-message:"
+
+var errUnsignedMessage = errors.New("message is not signed")
+
+// signMessage sets the signature & signer public key fields of msg, and the content hash of its attachments.
+func signMessage(msg *pb.Message, key crypto.PrivKey) error {
+	for _, a := range msg.Attachments {
+		if audio := a.GetAudio(); audio != nil {
+			audio.ContentSha256 = audioContentHash(audio.Frames)
+		}
+	}
+
+	pubKey, err := crypto.MarshalPublicKey(key.GetPublic())
+	if err != nil {
+		return err
+	}
+	msg.SignerPublicKey = pubKey
+
+	data, err := messageSigningBytes(msg)
+	if err != nil {
+		return err
+	}
+	sig, err := key.Sign(data)
+	if err != nil {
+		return err
+	}
+	msg.Signature = sig
+	return nil
+}
+
+// VerifyMessage checks that msg was signed by the key belonging to its author.
+func VerifyMessage(msg *pb.Message) error {
+	if len(msg.Signature) == 0 {
+		return errUnsignedMessage
+	}
+	if msg.Author == nil {
+		return fmt.Errorf("message has no author")
+	}
+
+	pubKey, err := crypto.UnmarshalPublicKey(msg.SignerPublicKey)
+	if err != nil {
+		return fmt.Errorf("invalid signer public key: %w", err)
+	}
+	signer, err := peer.IDFromPublicKey(pubKey)
+	if err != nil {
+		return err
+	}
+	if signer.Pretty() != msg.Author.PeerId {
+		return fmt.Errorf("message from %s was signed by %s", msg.Author.PeerId, signer.Pretty())
+	}
+
+	// the signature only covers the hash of each attachment, so check the content against it.
+	// Stored messages have had their frames stripped, and only the hash is left to check.
+	for _, a := range msg.Attachments {
+		audio := a.GetAudio()
+		if audio == nil || len(audio.Frames) == 0 {
+			continue
+		}
+		if !bytes.Equal(audioContentHash(audio.Frames), audio.ContentSha256) {
+			return fmt.Errorf("content of attachment %s doesn't match its hash", a.Id)
+		}
+	}
+
+	data, err := messageSigningBytes(msg)
+	if err != nil {
+		return err
+	}
+	ok, err := pubKey.Verify(data, msg.Signature)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("invalid signature on message from %s", msg.Author.PeerId)
+	}
+	return nil
+}
+
+// messageSigningBytes returns the protobuf encoding of the parts of msg that its signature covers.
+// Attachments are only represented by their content hash, so the signature stays valid once the content is
+// stored somewhere else.
+func messageSigningBytes(msg *pb.Message) ([]byte, error) {
+	content := &pb.SignedMessageContent{
+		MessageId:      msg.MessageId,
+		Author:         msg.Author,
+		SentAtTimeUnix: msg.SentAtTimeUnix,
+		TextContent:    msg.TextContent,
+	}
+	for _, a := range msg.Attachments {
+		sa := &pb.SignedAttachment{Id: a.Id}
+		if audio := a.GetAudio(); audio != nil {
+			sa.Codec = audio.Codec
+			sa.ContentSha256 = audio.ContentSha256
+		}
+		content.Attachments = append(content.Attachments, sa)
+	}
+
+	buf, err := content.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(messageSignaturePrefix), buf...), nil
+}
+
+// audioContentHash returns the sha256 of frames, each prefixed by its length so the boundaries are covered too.
+func audioContentHash(frames [][]byte) []byte {
+	h := sha256.New()
+	var lenBuf [binary.MaxVarintLen64]byte
+	for _, f := range frames {
+		n := binary.PutUvarint(lenBuf[:], uint64(len(f)))
+		h.Write(lenBuf[:n])
+		h.Write(f)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,216 @@
+package p2p
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+func testIdentity(t *testing.T, nick string) (crypto.PrivKey, *pb.UserInfo) {
+	t.Helper()
+	key, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %s", err)
+	}
+	pid, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		t.Fatalf("error getting peer id: %s", err)
+	}
+	return key, &pb.UserInfo{PeerId: pid.Pretty(), Nickname: nick}
+}
+
+func audioAttachment(id string, frames ...[]byte) *pb.Attachment {
+	return &pb.Attachment{
+		Id: id,
+		Kind: &pb.Attachment_Audio{Audio: &pb.AudioAttachment{
+			Codec:       pb.AttachmentTypeAudioOpus,
+			FrameSizeMs: 20,
+			Frames:      frames,
+		}},
+	}
+}
+
+func testMessage(author *pb.UserInfo, id string, text string, attachments ...*pb.Attachment) *pb.Message {
+	return &pb.Message{
+		MessageId:      id,
+		Author:         author,
+		SentAtTimeUnix: 1600000000,
+		TextContent:    text,
+		Attachments:    attachments,
+	}
+}
+
+func signedTestMessage(t *testing.T, key crypto.PrivKey, msg *pb.Message) *pb.Message {
+	t.Helper()
+	if err := signMessage(msg, key); err != nil {
+		t.Fatalf("error signing message: %s", err)
+	}
+	return msg
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	key, alice := testIdentity(t, "alice")
+
+	tests := []struct {
+		name string
+		msg  *pb.Message
+	}{
+		{"text", testMessage(alice, "m1", "hello")},
+		{"empty", testMessage(alice, "m2", "")},
+		{"audio", testMessage(alice, "m3", "listen", audioAttachment("r1", []byte{1, 2, 3}, []byte{4, 5}))},
+		{"several attachments", testMessage(alice, "m4", "",
+			audioAttachment("r1", []byte{1}),
+			audioAttachment("r2", []byte{2}, []byte{3}))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := signedTestMessage(t, key, tt.msg)
+			if err := VerifyMessage(msg); err != nil {
+				t.Fatalf("expected signed message to verify, got %s", err)
+			}
+
+			// it has to survive the trip over the wire, too
+			buf, err := msg.Marshal()
+			if err != nil {
+				t.Fatalf("error encoding message: %s", err)
+			}
+			decoded := &pb.Message{}
+			if err := decoded.Unmarshal(buf); err != nil {
+				t.Fatalf("error decoding message: %s", err)
+			}
+			if err := VerifyMessage(decoded); err != nil {
+				t.Fatalf("expected decoded message to verify, got %s", err)
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsTampering(t *testing.T) {
+	key, alice := testIdentity(t, "alice")
+	malloryKey, mallory := testIdentity(t, "mallory")
+
+	tests := []struct {
+		name   string
+		tamper func(msg *pb.Message)
+	}{
+		{"unsigned", func(msg *pb.Message) {
+			msg.Signature = nil
+		}},
+		{"text", func(msg *pb.Message) {
+			msg.TextContent = "goodbye"
+		}},
+		{"message id", func(msg *pb.Message) {
+			msg.MessageId = "m2"
+		}},
+		{"sent time", func(msg *pb.Message) {
+			msg.SentAtTimeUnix++
+		}},
+		{"author nickname", func(msg *pb.Message) {
+			msg.Author = &pb.UserInfo{PeerId: alice.PeerId, Nickname: "mallory"}
+		}},
+		{"author peer id", func(msg *pb.Message) {
+			msg.Author = mallory
+		}},
+		{"author and signer key", func(msg *pb.Message) {
+			// claiming the message as mallory's own still needs mallory's signature
+			msg.Author = mallory
+			pubKey, err := crypto.MarshalPublicKey(malloryKey.GetPublic())
+			if err != nil {
+				t.Fatalf("error encoding public key: %s", err)
+			}
+			msg.SignerPublicKey = pubKey
+		}},
+		{"attachment frames", func(msg *pb.Message) {
+			msg.Attachments[0].GetAudio().Frames[0] = []byte{9, 9, 9}
+		}},
+		{"attachment frame boundaries", func(msg *pb.Message) {
+			msg.Attachments[0].GetAudio().Frames = [][]byte{{1, 2}, {3, 4, 5}}
+		}},
+		{"attachment hash and frames", func(msg *pb.Message) {
+			audio := msg.Attachments[0].GetAudio()
+			audio.Frames = [][]byte{{9}}
+			audio.ContentSha256 = audioContentHash(audio.Frames)
+		}},
+		{"attachment id", func(msg *pb.Message) {
+			msg.Attachments[0].Id = "r2"
+		}},
+		{"extra attachment", func(msg *pb.Message) {
+			msg.Attachments = append(msg.Attachments, audioAttachment("r2", []byte{1}))
+		}},
+		{"removed attachment", func(msg *pb.Message) {
+			msg.Attachments = nil
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := signedTestMessage(t, key,
+				testMessage(alice, "m1", "hello", audioAttachment("r1", []byte{1, 2, 3}, []byte{4, 5})))
+			tt.tamper(msg)
+			if err := VerifyMessage(msg); err == nil {
+				t.Fatalf("expected tampered message to fail verification")
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsWrongKey(t *testing.T) {
+	_, alice := testIdentity(t, "alice")
+	malloryKey, _ := testIdentity(t, "mallory")
+
+	msg := signedTestMessage(t, malloryKey, testMessage(alice, "m1", "it's really me"))
+	if err := VerifyMessage(msg); err == nil {
+		t.Fatalf("expected a message signed with someone else's key to fail verification")
+	}
+}
+
+func TestStrippedAttachmentsStillVerify(t *testing.T) {
+	key, alice := testIdentity(t, "alice")
+	frames := [][]byte{{1, 2, 3}, {4, 5}, {}}
+	msg := signedTestMessage(t, key, testMessage(alice, "m1", "", audioAttachment("r1", frames...)))
+
+	stripped := withoutAttachmentContent(msg)
+	if len(stripped.Attachments[0].GetAudio().Frames) != 0 {
+		t.Fatalf("expected the frames to be stripped")
+	}
+	if len(msg.Attachments[0].GetAudio().Frames) != len(frames) {
+		t.Fatalf("stripping changed the original message")
+	}
+
+	inlinedBytes, err := messageSigningBytes(msg)
+	if err != nil {
+		t.Fatalf("error encoding signed content: %s", err)
+	}
+	strippedBytes, err := messageSigningBytes(stripped)
+	if err != nil {
+		t.Fatalf("error encoding signed content: %s", err)
+	}
+	if !bytes.Equal(inlinedBytes, strippedBytes) {
+		t.Fatalf("stripped and inlined messages don't sign the same content")
+	}
+	if err := VerifyMessage(stripped); err != nil {
+		t.Fatalf("expected stripped message to verify, got %s", err)
+	}
+
+	// putting the same frames back verifies too, as it does when the message is sent again
+	restored := proto.Clone(stripped).(*pb.Message)
+	restored.Attachments[0].GetAudio().Frames = frames
+	if err := VerifyMessage(restored); err != nil {
+		t.Fatalf("expected restored message to verify, got %s", err)
+	}
+}
+
+func TestAudioContentHashCoversFrameBoundaries(t *testing.T) {
+	a := audioContentHash([][]byte{{1, 2}, {3}})
+	b := audioContentHash([][]byte{{1}, {2, 3}})
+	if bytes.Equal(a, b) {
+		t.Fatalf("expected different frame boundaries to hash differently")
+	}
+	if !bytes.Equal(a, audioContentHash([][]byte{{1, 2}, {3}})) {
+		t.Fatalf("expected the same frames to hash the same")
+	}
+}
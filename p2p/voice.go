@@ -0,0 +1,183 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	pbio "github.com/gogo/protobuf/io"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/yusefnapora/party-line/audio"
+	pb "github.com/yusefnapora/party-line/types"
+)
+
+// voiceProtocolID carries live push-to-talk audio. Each talk gets its own stream to each peer,
+// so audio never waits behind chat messages or attachments.
+const voiceProtocolID = "/hacks/party-line/voice/1.0.0"
+
+const (
+	// how many frames can wait for a slow peer before we start dropping them. With 20ms frames this is a second.
+	voiceQueueSize = 50
+
+	voiceStreamOpenTimeout = 10 * time.Second
+
+	// a peer that can't take a frame in this long is dropped from the talk, so it can't hold up anyone else
+	voiceWriteTimeout = 2 * time.Second
+)
+
+// outgoingTalk sends the frames of one talk to every peer we were connected to when it started.
+type outgoingTalk struct {
+	id    string
+	peers map[string]chan *pb.VoiceFrame
+}
+
+func (t *outgoingTalk) send(f *pb.VoiceFrame) {
+	for pidStr, ch := range t.peers {
+		select {
+		case ch <- f:
+		default:
+			fmt.Printf("voice stream to %s is falling behind, dropping frame %d\n", pidStr, f.Seq)
+		}
+	}
+}
+
+// end closes every peer's queue. Their writers send the end marker once they've caught up.
+func (t *outgoingTalk) end() {
+	for _, ch := range t.peers {
+		close(ch)
+	}
+}
+
+// voiceLoop streams the frames from the recorder to connected peers.
+func (p *PartyLinePeer) voiceLoop() {
+	var talk *outgoingTalk
+	for f := range p.voiceCh {
+		if talk == nil || talk.id != f.TalkId {
+			if talk != nil {
+				// we missed the end of the last one
+				talk.end()
+			}
+			talk = p.startTalk(f.TalkId)
+		}
+
+		if f.End {
+			talk.end()
+			talk = nil
+			continue
+		}
+		talk.send(f)
+	}
+}
+
+func (p *PartyLinePeer) startTalk(talkID string) *outgoingTalk {
+	talk := &outgoingTalk{id: talkID, peers: make(map[string]chan *pb.VoiceFrame)}
+
+	p.fanoutLk.Lock()
+	for pidStr := range p.fanout {
+		pid, err := peer.Decode(pidStr)
+		if err != nil {
+			continue
+		}
+		ch := make(chan *pb.VoiceFrame, voiceQueueSize)
+		talk.peers[pidStr] = ch
+		go p.streamVoice(pid, talkID, ch)
+	}
+	p.fanoutLk.Unlock()
+
+	fmt.Printf("streaming live voice to %d peer(s)\n", len(talk.peers))
+	return talk
+}
+
+// streamVoice opens a voice stream to pid and writes frames from ch until it's closed, then sends the end marker.
+// It never blocks the sender: if the stream can't be opened or a write fails, the rest of ch is drained.
+func (p *PartyLinePeer) streamVoice(pid peer.ID, talkID string, ch <-chan *pb.VoiceFrame) {
+	ctx, cancel := context.WithTimeout(context.Background(), voiceStreamOpenTimeout)
+	s, err := p.host.NewStream(ctx, pid, voiceProtocolID)
+	cancel()
+	if err != nil {
+		fmt.Printf("unable to open voice stream to %s: %s\n", pid.Pretty(), err)
+		for range ch {
+		}
+		return
+	}
+	defer s.Close()
+
+	w := pbio.NewDelimitedWriter(s)
+	write := func(f *pb.VoiceFrame) error {
+		if err := s.SetWriteDeadline(time.Now().Add(voiceWriteTimeout)); err != nil {
+			return err
+		}
+		return w.WriteMsg(f)
+	}
+
+	var seq uint32
+	for f := range ch {
+		if err := write(f); err != nil {
+			fmt.Printf("error sending voice to %s: %s\n", pid.Pretty(), err)
+			_ = s.Reset()
+			for range ch {
+			}
+			return
+		}
+		seq = f.Seq + 1
+	}
+	if err := write(&pb.VoiceFrame{TalkId: talkID, Seq: seq, End: true}); err != nil {
+		fmt.Printf("error ending voice stream to %s: %s\n", pid.Pretty(), err)
+		_ = s.Reset()
+	}
+}
+
+// handleVoiceStream plays live audio from a peer as it arrives.
+func (p *PartyLinePeer) handleVoiceStream(s network.Stream) {
+	pid := s.Conn().RemotePeer()
+
+	// we only know who's talking if they've said Hello
+	if !p.isConnected(pid.Pretty()) {
+		fmt.Printf("ignoring voice stream from %s, since we don't have a session with them\n", pid.Pretty())
+		_ = s.Reset()
+		return
+	}
+	p.connsLk.Lock()
+	talker := p.getPeerConn(pid).user
+	p.connsLk.Unlock()
+
+	defer s.Close()
+
+	r := pbio.NewDelimitedReader(s, maxMessageSize)
+	var talkID string
+	var live *audio.LiveStream
+	for {
+		f := &pb.VoiceFrame{}
+		if err := r.ReadMsg(f); err != nil {
+			if err != io.EOF {
+				fmt.Printf("error reading voice from %s: %s\n", pid.Pretty(), err)
+			}
+			break
+		}
+
+		if live == nil {
+			var err error
+			live, err = p.audioStore.OpenLiveStream(pid.Pretty(), f.TalkId)
+			if err != nil {
+				fmt.Printf("unable to play voice from %s: %s\n", pid.Pretty(), err)
+				_ = s.Reset()
+				return
+			}
+			talkID = f.TalkId
+			fmt.Printf("%s started talking\n", talker.GetNickname())
+			p.dispatcher.LiveVoiceStarted(talkID, talker)
+		}
+
+		if f.End {
+			break
+		}
+		live.Push(f.Seq, f.TimestampMs, f.OpusFrame)
+	}
+
+	if live != nil {
+		live.Close()
+		p.dispatcher.LiveVoiceEnded(talkID, talker)
+	}
+}
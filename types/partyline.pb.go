@@ -4,6 +4,7 @@
 package types
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -22,6 +23,102 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ReceiptType int32
+
+const (
+	ReceiptType_DELIVERED ReceiptType = 0
+	ReceiptType_READ      ReceiptType = 1
+)
+
+var ReceiptType_name = map[int32]string{
+	0: "DELIVERED",
+	1: "READ",
+}
+
+var ReceiptType_value = map[string]int32{
+	"DELIVERED": 0,
+	"READ":      1,
+}
+
+func (x ReceiptType) String() string {
+	return proto.EnumName(ReceiptType_name, int32(x))
+}
+
+func (ReceiptType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{0}
+}
+
+// ConnectionState describes our party-line session with a peer.
+type ConnectionState int32
+
+const (
+	ConnectionState_DISCONNECTED ConnectionState = 0
+	// dialing the peer
+	ConnectionState_CONNECTING ConnectionState = 1
+	// stream is open, but we haven't exchanged Hello messages yet
+	ConnectionState_CONNECTED ConnectionState = 2
+	// chatting via a circuit relay
+	ConnectionState_RELAYED ConnectionState = 3
+	// chatting via a direct connection
+	ConnectionState_DIRECT ConnectionState = 4
+)
+
+var ConnectionState_name = map[int32]string{
+	0: "DISCONNECTED",
+	1: "CONNECTING",
+	2: "CONNECTED",
+	3: "RELAYED",
+	4: "DIRECT",
+}
+
+var ConnectionState_value = map[string]int32{
+	"DISCONNECTED": 0,
+	"CONNECTING":   1,
+	"CONNECTED":    2,
+	"RELAYED":      3,
+	"DIRECT":       4,
+}
+
+func (x ConnectionState) String() string {
+	return proto.EnumName(ConnectionState_name, int32(x))
+}
+
+func (ConnectionState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{1}
+}
+
+// values are prefixed since they'd otherwise clash with ReceiptType in the package scope.
+type OutboxStatus int32
+
+const (
+	// the recipient is offline, so the message will be sent when they reconnect
+	OutboxStatus_OUTBOX_QUEUED OutboxStatus = 0
+	// the recipient confirmed they got the queued message
+	OutboxStatus_OUTBOX_DELIVERED OutboxStatus = 1
+	// the message was dropped from the outbox before the recipient came back online
+	OutboxStatus_OUTBOX_EXPIRED OutboxStatus = 2
+)
+
+var OutboxStatus_name = map[int32]string{
+	0: "OUTBOX_QUEUED",
+	1: "OUTBOX_DELIVERED",
+	2: "OUTBOX_EXPIRED",
+}
+
+var OutboxStatus_value = map[string]int32{
+	"OUTBOX_QUEUED":    0,
+	"OUTBOX_DELIVERED": 1,
+	"OUTBOX_EXPIRED":   2,
+}
+
+func (x OutboxStatus) String() string {
+	return proto.EnumName(OutboxStatus_name, int32(x))
+}
+
+func (OutboxStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{2}
+}
+
 // UserInfo describes a user.
 type UserInfo struct {
 	PeerId   string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
@@ -250,6 +347,9 @@ type AudioAttachment struct {
 	Codec       string   `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
 	FrameSizeMs int32    `protobuf:"varint,2,opt,name=frame_size_ms,json=frameSizeMs,proto3" json:"frame_size_ms,omitempty"`
 	Frames      [][]byte `protobuf:"bytes,3,rep,name=frames,proto3" json:"frames,omitempty"`
+	// sha256 over the frames, each prefixed with its length as a uvarint. The message signature covers this
+	// instead of the frames, and it's kept when the frames are stripped, so stored messages can still be verified.
+	ContentSha256 []byte `protobuf:"bytes,4,opt,name=content_sha256,json=contentSha256,proto3" json:"content_sha256,omitempty"`
 }
 
 func (m *AudioAttachment) Reset()         { *m = AudioAttachment{} }
@@ -306,12 +406,26 @@ func (m *AudioAttachment) GetFrames() [][]byte {
 	return nil
 }
 
+func (m *AudioAttachment) GetContentSha256() []byte {
+	if m != nil {
+		return m.ContentSha256
+	}
+	return nil
+}
+
 // Message can have text and zero or more attachments.
 type Message struct {
 	Author         *UserInfo     `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
 	SentAtTimeUnix int64         `protobuf:"varint,2,opt,name=sent_at_time_unix,json=sentAtTimeUnix,proto3" json:"sent_at_time_unix,omitempty"`
 	TextContent    string        `protobuf:"bytes,3,opt,name=text_content,json=textContent,proto3" json:"text_content,omitempty"`
 	Attachments    []*Attachment `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// signature over the protobuf encoding of this message's SignedMessageContent,
+	// made with the author's libp2p identity key.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// protobuf encoded libp2p public key of the author. Its peer id must match author.peer_id.
+	SignerPublicKey []byte `protobuf:"bytes,6,opt,name=signer_public_key,json=signerPublicKey,proto3" json:"signer_public_key,omitempty"`
+	// unique id assigned by the author's dispatcher when the message is sent.
+	MessageId string `protobuf:"bytes,7,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 }
 
 func (m *Message) Reset()         { *m = Message{} }
@@ -375,25 +489,49 @@ func (m *Message) GetAttachments() []*Attachment {
 	return nil
 }
 
-// InputDeviceInfo describes an audio capture device.
-type InputDeviceInfo struct {
-	DeviceId  string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IsDefault bool   `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+func (m *Message) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
 }
 
-func (m *InputDeviceInfo) Reset()         { *m = InputDeviceInfo{} }
-func (m *InputDeviceInfo) String() string { return proto.CompactTextString(m) }
-func (*InputDeviceInfo) ProtoMessage()    {}
-func (*InputDeviceInfo) Descriptor() ([]byte, []int) {
+func (m *Message) GetSignerPublicKey() []byte {
+	if m != nil {
+		return m.SignerPublicKey
+	}
+	return nil
+}
+
+func (m *Message) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+// SignedMessageContent is what a message signature covers: the message's own fields, with each
+// attachment's content replaced by its hash.
+type SignedMessageContent struct {
+	MessageId      string              `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Author         *UserInfo           `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	SentAtTimeUnix int64               `protobuf:"varint,3,opt,name=sent_at_time_unix,json=sentAtTimeUnix,proto3" json:"sent_at_time_unix,omitempty"`
+	TextContent    string              `protobuf:"bytes,4,opt,name=text_content,json=textContent,proto3" json:"text_content,omitempty"`
+	Attachments    []*SignedAttachment `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+}
+
+func (m *SignedMessageContent) Reset()         { *m = SignedMessageContent{} }
+func (m *SignedMessageContent) String() string { return proto.CompactTextString(m) }
+func (*SignedMessageContent) ProtoMessage()    {}
+func (*SignedMessageContent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{6}
 }
-func (m *InputDeviceInfo) XXX_Unmarshal(b []byte) error {
+func (m *SignedMessageContent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InputDeviceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignedMessageContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InputDeviceInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignedMessageContent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -403,55 +541,71 @@ func (m *InputDeviceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *InputDeviceInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InputDeviceInfo.Merge(m, src)
+func (m *SignedMessageContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedMessageContent.Merge(m, src)
 }
-func (m *InputDeviceInfo) XXX_Size() int {
+func (m *SignedMessageContent) XXX_Size() int {
 	return m.Size()
 }
-func (m *InputDeviceInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_InputDeviceInfo.DiscardUnknown(m)
+func (m *SignedMessageContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedMessageContent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InputDeviceInfo proto.InternalMessageInfo
+var xxx_messageInfo_SignedMessageContent proto.InternalMessageInfo
 
-func (m *InputDeviceInfo) GetDeviceId() string {
+func (m *SignedMessageContent) GetMessageId() string {
 	if m != nil {
-		return m.DeviceId
+		return m.MessageId
 	}
 	return ""
 }
 
-func (m *InputDeviceInfo) GetName() string {
+func (m *SignedMessageContent) GetAuthor() *UserInfo {
 	if m != nil {
-		return m.Name
+		return m.Author
+	}
+	return nil
+}
+
+func (m *SignedMessageContent) GetSentAtTimeUnix() int64 {
+	if m != nil {
+		return m.SentAtTimeUnix
+	}
+	return 0
+}
+
+func (m *SignedMessageContent) GetTextContent() string {
+	if m != nil {
+		return m.TextContent
 	}
 	return ""
 }
 
-func (m *InputDeviceInfo) GetIsDefault() bool {
+func (m *SignedMessageContent) GetAttachments() []*SignedAttachment {
 	if m != nil {
-		return m.IsDefault
+		return m.Attachments
 	}
-	return false
+	return nil
 }
 
-type InputDeviceList struct {
-	Devices []*InputDeviceInfo `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+type SignedAttachment struct {
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Codec         string `protobuf:"bytes,2,opt,name=codec,proto3" json:"codec,omitempty"`
+	ContentSha256 []byte `protobuf:"bytes,3,opt,name=content_sha256,json=contentSha256,proto3" json:"content_sha256,omitempty"`
 }
 
-func (m *InputDeviceList) Reset()         { *m = InputDeviceList{} }
-func (m *InputDeviceList) String() string { return proto.CompactTextString(m) }
-func (*InputDeviceList) ProtoMessage()    {}
-func (*InputDeviceList) Descriptor() ([]byte, []int) {
+func (m *SignedAttachment) Reset()         { *m = SignedAttachment{} }
+func (m *SignedAttachment) String() string { return proto.CompactTextString(m) }
+func (*SignedAttachment) ProtoMessage()    {}
+func (*SignedAttachment) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{7}
 }
-func (m *InputDeviceList) XXX_Unmarshal(b []byte) error {
+func (m *SignedAttachment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InputDeviceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignedAttachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InputDeviceList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignedAttachment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -461,41 +615,60 @@ func (m *InputDeviceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *InputDeviceList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InputDeviceList.Merge(m, src)
+func (m *SignedAttachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedAttachment.Merge(m, src)
 }
-func (m *InputDeviceList) XXX_Size() int {
+func (m *SignedAttachment) XXX_Size() int {
 	return m.Size()
 }
-func (m *InputDeviceList) XXX_DiscardUnknown() {
-	xxx_messageInfo_InputDeviceList.DiscardUnknown(m)
+func (m *SignedAttachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedAttachment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InputDeviceList proto.InternalMessageInfo
+var xxx_messageInfo_SignedAttachment proto.InternalMessageInfo
 
-func (m *InputDeviceList) GetDevices() []*InputDeviceInfo {
+func (m *SignedAttachment) GetId() string {
 	if m != nil {
-		return m.Devices
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SignedAttachment) GetCodec() string {
+	if m != nil {
+		return m.Codec
+	}
+	return ""
+}
+
+func (m *SignedAttachment) GetContentSha256() []byte {
+	if m != nil {
+		return m.ContentSha256
 	}
 	return nil
 }
 
-type BeginAudioRecordingRequest struct {
-	MaxDuration string `protobuf:"bytes,1,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+// Receipt is sent back to the author of a message once the recipient's app has received it,
+// and again once the recipient has seen it in the UI.
+type Receipt struct {
+	MessageId     string      `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Recipient     *UserInfo   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Type          ReceiptType `protobuf:"varint,3,opt,name=type,proto3,enum=types.ReceiptType" json:"type,omitempty"`
+	TimestampUnix int64       `protobuf:"varint,4,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
 }
 
-func (m *BeginAudioRecordingRequest) Reset()         { *m = BeginAudioRecordingRequest{} }
-func (m *BeginAudioRecordingRequest) String() string { return proto.CompactTextString(m) }
-func (*BeginAudioRecordingRequest) ProtoMessage()    {}
-func (*BeginAudioRecordingRequest) Descriptor() ([]byte, []int) {
+func (m *Receipt) Reset()         { *m = Receipt{} }
+func (m *Receipt) String() string { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()    {}
+func (*Receipt) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{8}
 }
-func (m *BeginAudioRecordingRequest) XXX_Unmarshal(b []byte) error {
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BeginAudioRecordingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BeginAudioRecordingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Receipt.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -505,41 +678,64 @@ func (m *BeginAudioRecordingRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *BeginAudioRecordingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BeginAudioRecordingRequest.Merge(m, src)
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
 }
-func (m *BeginAudioRecordingRequest) XXX_Size() int {
+func (m *Receipt) XXX_Size() int {
 	return m.Size()
 }
-func (m *BeginAudioRecordingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BeginAudioRecordingRequest.DiscardUnknown(m)
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BeginAudioRecordingRequest proto.InternalMessageInfo
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
 
-func (m *BeginAudioRecordingRequest) GetMaxDuration() string {
+func (m *Receipt) GetMessageId() string {
 	if m != nil {
-		return m.MaxDuration
+		return m.MessageId
 	}
 	return ""
 }
 
-type StopAudioRecordingRequest struct {
-	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+func (m *Receipt) GetRecipient() *UserInfo {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
 }
 
-func (m *StopAudioRecordingRequest) Reset()         { *m = StopAudioRecordingRequest{} }
-func (m *StopAudioRecordingRequest) String() string { return proto.CompactTextString(m) }
-func (*StopAudioRecordingRequest) ProtoMessage()    {}
-func (*StopAudioRecordingRequest) Descriptor() ([]byte, []int) {
+func (m *Receipt) GetType() ReceiptType {
+	if m != nil {
+		return m.Type
+	}
+	return ReceiptType_DELIVERED
+}
+
+func (m *Receipt) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
+}
+
+// OutboxQueue is the on-disk format for messages waiting to be sent to a peer while they're offline.
+type OutboxQueue struct {
+	User    *UserInfo      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Entries []*OutboxEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *OutboxQueue) Reset()         { *m = OutboxQueue{} }
+func (m *OutboxQueue) String() string { return proto.CompactTextString(m) }
+func (*OutboxQueue) ProtoMessage()    {}
+func (*OutboxQueue) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{9}
 }
-func (m *StopAudioRecordingRequest) XXX_Unmarshal(b []byte) error {
+func (m *OutboxQueue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StopAudioRecordingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OutboxQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StopAudioRecordingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OutboxQueue.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -549,41 +745,49 @@ func (m *StopAudioRecordingRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *StopAudioRecordingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopAudioRecordingRequest.Merge(m, src)
+func (m *OutboxQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboxQueue.Merge(m, src)
 }
-func (m *StopAudioRecordingRequest) XXX_Size() int {
+func (m *OutboxQueue) XXX_Size() int {
 	return m.Size()
 }
-func (m *StopAudioRecordingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopAudioRecordingRequest.DiscardUnknown(m)
+func (m *OutboxQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboxQueue.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StopAudioRecordingRequest proto.InternalMessageInfo
+var xxx_messageInfo_OutboxQueue proto.InternalMessageInfo
 
-func (m *StopAudioRecordingRequest) GetRecordingId() string {
+func (m *OutboxQueue) GetUser() *UserInfo {
 	if m != nil {
-		return m.RecordingId
+		return m.User
 	}
-	return ""
+	return nil
 }
 
-type PlayAudioRecordingRequest struct {
-	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+func (m *OutboxQueue) GetEntries() []*OutboxEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-func (m *PlayAudioRecordingRequest) Reset()         { *m = PlayAudioRecordingRequest{} }
-func (m *PlayAudioRecordingRequest) String() string { return proto.CompactTextString(m) }
-func (*PlayAudioRecordingRequest) ProtoMessage()    {}
-func (*PlayAudioRecordingRequest) Descriptor() ([]byte, []int) {
+type OutboxEntry struct {
+	Message      *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	QueuedAtUnix int64    `protobuf:"varint,2,opt,name=queued_at_unix,json=queuedAtUnix,proto3" json:"queued_at_unix,omitempty"`
+}
+
+func (m *OutboxEntry) Reset()         { *m = OutboxEntry{} }
+func (m *OutboxEntry) String() string { return proto.CompactTextString(m) }
+func (*OutboxEntry) ProtoMessage()    {}
+func (*OutboxEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{10}
 }
-func (m *PlayAudioRecordingRequest) XXX_Unmarshal(b []byte) error {
+func (m *OutboxEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PlayAudioRecordingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OutboxEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PlayAudioRecordingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OutboxEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -593,42 +797,53 @@ func (m *PlayAudioRecordingRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *PlayAudioRecordingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlayAudioRecordingRequest.Merge(m, src)
+func (m *OutboxEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboxEntry.Merge(m, src)
 }
-func (m *PlayAudioRecordingRequest) XXX_Size() int {
+func (m *OutboxEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *PlayAudioRecordingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlayAudioRecordingRequest.DiscardUnknown(m)
+func (m *OutboxEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboxEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlayAudioRecordingRequest proto.InternalMessageInfo
+var xxx_messageInfo_OutboxEntry proto.InternalMessageInfo
 
-func (m *PlayAudioRecordingRequest) GetRecordingId() string {
+func (m *OutboxEntry) GetMessage() *Message {
 	if m != nil {
-		return m.RecordingId
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-type ConnectToPeerRequest struct {
-	// peer_locator is either a peer id or multiaddr with /p2p/ component
-	PeerLocator string `protobuf:"bytes,1,opt,name=peer_locator,json=peerLocator,proto3" json:"peer_locator,omitempty"`
+func (m *OutboxEntry) GetQueuedAtUnix() int64 {
+	if m != nil {
+		return m.QueuedAtUnix
+	}
+	return 0
 }
 
-func (m *ConnectToPeerRequest) Reset()         { *m = ConnectToPeerRequest{} }
-func (m *ConnectToPeerRequest) String() string { return proto.CompactTextString(m) }
-func (*ConnectToPeerRequest) ProtoMessage()    {}
-func (*ConnectToPeerRequest) Descriptor() ([]byte, []int) {
+// HistoryRequest is the first (and only) thing written on a history sync stream. The other side
+// replies with each message we're missing, then closes the stream.
+type HistoryRequest struct {
+	// only messages sent after this time are wanted
+	SinceUnix int64 `protobuf:"varint,1,opt,name=since_unix,json=sinceUnix,proto3" json:"since_unix,omitempty"`
+	// ids of messages we already have
+	KnownMessageIds []string `protobuf:"bytes,2,rep,name=known_message_ids,json=knownMessageIds,proto3" json:"known_message_ids,omitempty"`
+}
+
+func (m *HistoryRequest) Reset()         { *m = HistoryRequest{} }
+func (m *HistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*HistoryRequest) ProtoMessage()    {}
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{11}
 }
-func (m *ConnectToPeerRequest) XXX_Unmarshal(b []byte) error {
+func (m *HistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConnectToPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConnectToPeerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -638,45 +853,53 @@ func (m *ConnectToPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ConnectToPeerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConnectToPeerRequest.Merge(m, src)
+func (m *HistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRequest.Merge(m, src)
 }
-func (m *ConnectToPeerRequest) XXX_Size() int {
+func (m *HistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConnectToPeerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConnectToPeerRequest.DiscardUnknown(m)
+func (m *HistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConnectToPeerRequest proto.InternalMessageInfo
+var xxx_messageInfo_HistoryRequest proto.InternalMessageInfo
 
-func (m *ConnectToPeerRequest) GetPeerLocator() string {
+func (m *HistoryRequest) GetSinceUnix() int64 {
 	if m != nil {
-		return m.PeerLocator
+		return m.SinceUnix
 	}
-	return ""
+	return 0
 }
 
-type ApiResponse struct {
-	// Types that are valid to be assigned to Resp:
-	//	*ApiResponse_Ok
-	//	*ApiResponse_Error
-	//	*ApiResponse_BeginAudioRecording
-	Resp isApiResponse_Resp `protobuf_oneof:"resp"`
+func (m *HistoryRequest) GetKnownMessageIds() []string {
+	if m != nil {
+		return m.KnownMessageIds
+	}
+	return nil
 }
 
-func (m *ApiResponse) Reset()         { *m = ApiResponse{} }
-func (m *ApiResponse) String() string { return proto.CompactTextString(m) }
-func (*ApiResponse) ProtoMessage()    {}
-func (*ApiResponse) Descriptor() ([]byte, []int) {
+// Envelope wraps everything sent on a party-line stream after the Hello exchange.
+type Envelope struct {
+	// Types that are valid to be assigned to Payload:
+	//	*Envelope_Message
+	//	*Envelope_Goodbye
+	//	*Envelope_Receipt
+	Payload isEnvelope_Payload `protobuf_oneof:"payload"`
+}
+
+func (m *Envelope) Reset()         { *m = Envelope{} }
+func (m *Envelope) String() string { return proto.CompactTextString(m) }
+func (*Envelope) ProtoMessage()    {}
+func (*Envelope) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{12}
 }
-func (m *ApiResponse) XXX_Unmarshal(b []byte) error {
+func (m *Envelope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Envelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApiResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Envelope.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -686,91 +909,96 @@ func (m *ApiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ApiResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApiResponse.Merge(m, src)
+func (m *Envelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Envelope.Merge(m, src)
 }
-func (m *ApiResponse) XXX_Size() int {
+func (m *Envelope) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApiResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApiResponse.DiscardUnknown(m)
+func (m *Envelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_Envelope.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApiResponse proto.InternalMessageInfo
+var xxx_messageInfo_Envelope proto.InternalMessageInfo
 
-type isApiResponse_Resp interface {
-	isApiResponse_Resp()
+type isEnvelope_Payload interface {
+	isEnvelope_Payload()
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
 
-type ApiResponse_Ok struct {
-	Ok *OkResponse `protobuf:"bytes,1,opt,name=ok,proto3,oneof" json:"ok,omitempty"`
+type Envelope_Message struct {
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3,oneof" json:"message,omitempty"`
 }
-type ApiResponse_Error struct {
-	Error *ErrorResponse `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+type Envelope_Goodbye struct {
+	Goodbye *Goodbye `protobuf:"bytes,2,opt,name=goodbye,proto3,oneof" json:"goodbye,omitempty"`
 }
-type ApiResponse_BeginAudioRecording struct {
-	BeginAudioRecording *BeginAudioRecordingResponse `protobuf:"bytes,3,opt,name=begin_audio_recording,json=beginAudioRecording,proto3,oneof" json:"begin_audio_recording,omitempty"`
+type Envelope_Receipt struct {
+	Receipt *Receipt `protobuf:"bytes,3,opt,name=receipt,proto3,oneof" json:"receipt,omitempty"`
 }
 
-func (*ApiResponse_Ok) isApiResponse_Resp()                  {}
-func (*ApiResponse_Error) isApiResponse_Resp()               {}
-func (*ApiResponse_BeginAudioRecording) isApiResponse_Resp() {}
+func (*Envelope_Message) isEnvelope_Payload() {}
+func (*Envelope_Goodbye) isEnvelope_Payload() {}
+func (*Envelope_Receipt) isEnvelope_Payload() {}
 
-func (m *ApiResponse) GetResp() isApiResponse_Resp {
+func (m *Envelope) GetPayload() isEnvelope_Payload {
 	if m != nil {
-		return m.Resp
+		return m.Payload
 	}
 	return nil
 }
 
-func (m *ApiResponse) GetOk() *OkResponse {
-	if x, ok := m.GetResp().(*ApiResponse_Ok); ok {
-		return x.Ok
+func (m *Envelope) GetMessage() *Message {
+	if x, ok := m.GetPayload().(*Envelope_Message); ok {
+		return x.Message
 	}
 	return nil
 }
 
-func (m *ApiResponse) GetError() *ErrorResponse {
-	if x, ok := m.GetResp().(*ApiResponse_Error); ok {
-		return x.Error
+func (m *Envelope) GetGoodbye() *Goodbye {
+	if x, ok := m.GetPayload().(*Envelope_Goodbye); ok {
+		return x.Goodbye
 	}
 	return nil
 }
 
-func (m *ApiResponse) GetBeginAudioRecording() *BeginAudioRecordingResponse {
-	if x, ok := m.GetResp().(*ApiResponse_BeginAudioRecording); ok {
-		return x.BeginAudioRecording
+func (m *Envelope) GetReceipt() *Receipt {
+	if x, ok := m.GetPayload().(*Envelope_Receipt); ok {
+		return x.Receipt
 	}
 	return nil
 }
 
 // XXX_OneofWrappers is for the internal use of the proto package.
-func (*ApiResponse) XXX_OneofWrappers() []interface{} {
+func (*Envelope) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
-		(*ApiResponse_Ok)(nil),
-		(*ApiResponse_Error)(nil),
-		(*ApiResponse_BeginAudioRecording)(nil),
+		(*Envelope_Message)(nil),
+		(*Envelope_Goodbye)(nil),
+		(*Envelope_Receipt)(nil),
 	}
 }
 
-type ErrorResponse struct {
-	Details string `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+// InputDeviceInfo describes an audio capture device.
+type InputDeviceInfo struct {
+	DeviceId  string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsDefault bool   `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	// true for the device we're currently recording from
+	IsSelected bool `protobuf:"varint,4,opt,name=is_selected,json=isSelected,proto3" json:"is_selected,omitempty"`
 }
 
-func (m *ErrorResponse) Reset()         { *m = ErrorResponse{} }
-func (m *ErrorResponse) String() string { return proto.CompactTextString(m) }
-func (*ErrorResponse) ProtoMessage()    {}
-func (*ErrorResponse) Descriptor() ([]byte, []int) {
+func (m *InputDeviceInfo) Reset()         { *m = InputDeviceInfo{} }
+func (m *InputDeviceInfo) String() string { return proto.CompactTextString(m) }
+func (*InputDeviceInfo) ProtoMessage()    {}
+func (*InputDeviceInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{13}
 }
-func (m *ErrorResponse) XXX_Unmarshal(b []byte) error {
+func (m *InputDeviceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ErrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InputDeviceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ErrorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InputDeviceInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -780,40 +1008,62 @@ func (m *ErrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ErrorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ErrorResponse.Merge(m, src)
+func (m *InputDeviceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InputDeviceInfo.Merge(m, src)
 }
-func (m *ErrorResponse) XXX_Size() int {
+func (m *InputDeviceInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *ErrorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ErrorResponse.DiscardUnknown(m)
+func (m *InputDeviceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_InputDeviceInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ErrorResponse proto.InternalMessageInfo
+var xxx_messageInfo_InputDeviceInfo proto.InternalMessageInfo
 
-func (m *ErrorResponse) GetDetails() string {
+func (m *InputDeviceInfo) GetDeviceId() string {
 	if m != nil {
-		return m.Details
+		return m.DeviceId
 	}
 	return ""
 }
 
-type OkResponse struct {
+func (m *InputDeviceInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *OkResponse) Reset()         { *m = OkResponse{} }
-func (m *OkResponse) String() string { return proto.CompactTextString(m) }
-func (*OkResponse) ProtoMessage()    {}
-func (*OkResponse) Descriptor() ([]byte, []int) {
+func (m *InputDeviceInfo) GetIsDefault() bool {
+	if m != nil {
+		return m.IsDefault
+	}
+	return false
+}
+
+func (m *InputDeviceInfo) GetIsSelected() bool {
+	if m != nil {
+		return m.IsSelected
+	}
+	return false
+}
+
+type InputDeviceList struct {
+	Devices []*InputDeviceInfo `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (m *InputDeviceList) Reset()         { *m = InputDeviceList{} }
+func (m *InputDeviceList) String() string { return proto.CompactTextString(m) }
+func (*InputDeviceList) ProtoMessage()    {}
+func (*InputDeviceList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{14}
 }
-func (m *OkResponse) XXX_Unmarshal(b []byte) error {
+func (m *InputDeviceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InputDeviceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OkResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InputDeviceList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -823,34 +1073,42 @@ func (m *OkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *OkResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OkResponse.Merge(m, src)
+func (m *InputDeviceList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InputDeviceList.Merge(m, src)
 }
-func (m *OkResponse) XXX_Size() int {
+func (m *InputDeviceList) XXX_Size() int {
 	return m.Size()
 }
-func (m *OkResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OkResponse.DiscardUnknown(m)
+func (m *InputDeviceList) XXX_DiscardUnknown() {
+	xxx_messageInfo_InputDeviceList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OkResponse proto.InternalMessageInfo
+var xxx_messageInfo_InputDeviceList proto.InternalMessageInfo
 
-type BeginAudioRecordingResponse struct {
-	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+func (m *InputDeviceList) GetDevices() []*InputDeviceInfo {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
 }
 
-func (m *BeginAudioRecordingResponse) Reset()         { *m = BeginAudioRecordingResponse{} }
-func (m *BeginAudioRecordingResponse) String() string { return proto.CompactTextString(m) }
-func (*BeginAudioRecordingResponse) ProtoMessage()    {}
-func (*BeginAudioRecordingResponse) Descriptor() ([]byte, []int) {
+// SelectInputDeviceRequest picks the microphone used for recording. An empty device_id selects the system default.
+type SelectInputDeviceRequest struct {
+	DeviceId string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+}
+
+func (m *SelectInputDeviceRequest) Reset()         { *m = SelectInputDeviceRequest{} }
+func (m *SelectInputDeviceRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectInputDeviceRequest) ProtoMessage()    {}
+func (*SelectInputDeviceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{15}
 }
-func (m *BeginAudioRecordingResponse) XXX_Unmarshal(b []byte) error {
+func (m *SelectInputDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BeginAudioRecordingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SelectInputDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BeginAudioRecordingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SelectInputDeviceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -860,48 +1118,46 @@ func (m *BeginAudioRecordingResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *BeginAudioRecordingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BeginAudioRecordingResponse.Merge(m, src)
+func (m *SelectInputDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectInputDeviceRequest.Merge(m, src)
 }
-func (m *BeginAudioRecordingResponse) XXX_Size() int {
+func (m *SelectInputDeviceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BeginAudioRecordingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BeginAudioRecordingResponse.DiscardUnknown(m)
+func (m *SelectInputDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectInputDeviceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BeginAudioRecordingResponse proto.InternalMessageInfo
+var xxx_messageInfo_SelectInputDeviceRequest proto.InternalMessageInfo
 
-func (m *BeginAudioRecordingResponse) GetRecordingId() string {
+func (m *SelectInputDeviceRequest) GetDeviceId() string {
 	if m != nil {
-		return m.RecordingId
+		return m.DeviceId
 	}
 	return ""
 }
 
-type Event struct {
-	TimestampUnix int64 `protobuf:"varint,1,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
-	// Types that are valid to be assigned to Evt:
-	//	*Event_UserJoined
-	//	*Event_UserLeft
-	//	*Event_MessageReceived
-	//	*Event_MessageSent
-	//	*Event_ConnectToPeerRequested
-	Evt isEvent_Evt `protobuf_oneof:"evt"`
+// OutputDeviceInfo describes an audio playback device.
+type OutputDeviceInfo struct {
+	DeviceId  string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsDefault bool   `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	// true for the device we're currently playing on
+	IsSelected bool `protobuf:"varint,4,opt,name=is_selected,json=isSelected,proto3" json:"is_selected,omitempty"`
 }
 
-func (m *Event) Reset()         { *m = Event{} }
-func (m *Event) String() string { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()    {}
-func (*Event) Descriptor() ([]byte, []int) {
+func (m *OutputDeviceInfo) Reset()         { *m = OutputDeviceInfo{} }
+func (m *OutputDeviceInfo) String() string { return proto.CompactTextString(m) }
+func (*OutputDeviceInfo) ProtoMessage()    {}
+func (*OutputDeviceInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{16}
 }
-func (m *Event) XXX_Unmarshal(b []byte) error {
+func (m *OutputDeviceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OutputDeviceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OutputDeviceInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -911,122 +1167,62 @@ func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Event) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event.Merge(m, src)
+func (m *OutputDeviceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputDeviceInfo.Merge(m, src)
 }
-func (m *Event) XXX_Size() int {
+func (m *OutputDeviceInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Event proto.InternalMessageInfo
-
-type isEvent_Evt interface {
-	isEvent_Evt()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type Event_UserJoined struct {
-	UserJoined *UserJoinedEvent `protobuf:"bytes,101,opt,name=user_joined,json=userJoined,proto3,oneof" json:"user_joined,omitempty"`
-}
-type Event_UserLeft struct {
-	UserLeft *UserLeftEvent `protobuf:"bytes,102,opt,name=user_left,json=userLeft,proto3,oneof" json:"user_left,omitempty"`
-}
-type Event_MessageReceived struct {
-	MessageReceived *MessageReceivedEvent `protobuf:"bytes,103,opt,name=message_received,json=messageReceived,proto3,oneof" json:"message_received,omitempty"`
-}
-type Event_MessageSent struct {
-	MessageSent *MessageSentEvent `protobuf:"bytes,104,opt,name=message_sent,json=messageSent,proto3,oneof" json:"message_sent,omitempty"`
-}
-type Event_ConnectToPeerRequested struct {
-	ConnectToPeerRequested *ConnectToPeerRequestedEvent `protobuf:"bytes,105,opt,name=connect_to_peer_requested,json=connectToPeerRequested,proto3,oneof" json:"connect_to_peer_requested,omitempty"`
+func (m *OutputDeviceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputDeviceInfo.DiscardUnknown(m)
 }
 
-func (*Event_UserJoined) isEvent_Evt()             {}
-func (*Event_UserLeft) isEvent_Evt()               {}
-func (*Event_MessageReceived) isEvent_Evt()        {}
-func (*Event_MessageSent) isEvent_Evt()            {}
-func (*Event_ConnectToPeerRequested) isEvent_Evt() {}
+var xxx_messageInfo_OutputDeviceInfo proto.InternalMessageInfo
 
-func (m *Event) GetEvt() isEvent_Evt {
+func (m *OutputDeviceInfo) GetDeviceId() string {
 	if m != nil {
-		return m.Evt
+		return m.DeviceId
 	}
-	return nil
+	return ""
 }
 
-func (m *Event) GetTimestampUnix() int64 {
+func (m *OutputDeviceInfo) GetName() string {
 	if m != nil {
-		return m.TimestampUnix
-	}
-	return 0
-}
-
-func (m *Event) GetUserJoined() *UserJoinedEvent {
-	if x, ok := m.GetEvt().(*Event_UserJoined); ok {
-		return x.UserJoined
-	}
-	return nil
-}
-
-func (m *Event) GetUserLeft() *UserLeftEvent {
-	if x, ok := m.GetEvt().(*Event_UserLeft); ok {
-		return x.UserLeft
-	}
-	return nil
-}
-
-func (m *Event) GetMessageReceived() *MessageReceivedEvent {
-	if x, ok := m.GetEvt().(*Event_MessageReceived); ok {
-		return x.MessageReceived
-	}
-	return nil
-}
-
-func (m *Event) GetMessageSent() *MessageSentEvent {
-	if x, ok := m.GetEvt().(*Event_MessageSent); ok {
-		return x.MessageSent
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *Event) GetConnectToPeerRequested() *ConnectToPeerRequestedEvent {
-	if x, ok := m.GetEvt().(*Event_ConnectToPeerRequested); ok {
-		return x.ConnectToPeerRequested
+func (m *OutputDeviceInfo) GetIsDefault() bool {
+	if m != nil {
+		return m.IsDefault
 	}
-	return nil
+	return false
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Event) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Event_UserJoined)(nil),
-		(*Event_UserLeft)(nil),
-		(*Event_MessageReceived)(nil),
-		(*Event_MessageSent)(nil),
-		(*Event_ConnectToPeerRequested)(nil),
+func (m *OutputDeviceInfo) GetIsSelected() bool {
+	if m != nil {
+		return m.IsSelected
 	}
+	return false
 }
 
-type UserJoinedEvent struct {
-	User *UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+type OutputDeviceList struct {
+	Devices []*OutputDeviceInfo `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
 }
 
-func (m *UserJoinedEvent) Reset()         { *m = UserJoinedEvent{} }
-func (m *UserJoinedEvent) String() string { return proto.CompactTextString(m) }
-func (*UserJoinedEvent) ProtoMessage()    {}
-func (*UserJoinedEvent) Descriptor() ([]byte, []int) {
+func (m *OutputDeviceList) Reset()         { *m = OutputDeviceList{} }
+func (m *OutputDeviceList) String() string { return proto.CompactTextString(m) }
+func (*OutputDeviceList) ProtoMessage()    {}
+func (*OutputDeviceList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{17}
 }
-func (m *UserJoinedEvent) XXX_Unmarshal(b []byte) error {
+func (m *OutputDeviceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UserJoinedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OutputDeviceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UserJoinedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OutputDeviceList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1036,41 +1232,42 @@ func (m *UserJoinedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *UserJoinedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserJoinedEvent.Merge(m, src)
+func (m *OutputDeviceList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputDeviceList.Merge(m, src)
 }
-func (m *UserJoinedEvent) XXX_Size() int {
+func (m *OutputDeviceList) XXX_Size() int {
 	return m.Size()
 }
-func (m *UserJoinedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserJoinedEvent.DiscardUnknown(m)
+func (m *OutputDeviceList) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputDeviceList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserJoinedEvent proto.InternalMessageInfo
+var xxx_messageInfo_OutputDeviceList proto.InternalMessageInfo
 
-func (m *UserJoinedEvent) GetUser() *UserInfo {
+func (m *OutputDeviceList) GetDevices() []*OutputDeviceInfo {
 	if m != nil {
-		return m.User
+		return m.Devices
 	}
 	return nil
 }
 
-type UserLeftEvent struct {
-	User *UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+// SelectOutputDeviceRequest picks the device used for playback. An empty device_id selects the system default.
+type SelectOutputDeviceRequest struct {
+	DeviceId string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 }
 
-func (m *UserLeftEvent) Reset()         { *m = UserLeftEvent{} }
-func (m *UserLeftEvent) String() string { return proto.CompactTextString(m) }
-func (*UserLeftEvent) ProtoMessage()    {}
-func (*UserLeftEvent) Descriptor() ([]byte, []int) {
+func (m *SelectOutputDeviceRequest) Reset()         { *m = SelectOutputDeviceRequest{} }
+func (m *SelectOutputDeviceRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectOutputDeviceRequest) ProtoMessage()    {}
+func (*SelectOutputDeviceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{18}
 }
-func (m *UserLeftEvent) XXX_Unmarshal(b []byte) error {
+func (m *SelectOutputDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UserLeftEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SelectOutputDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UserLeftEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SelectOutputDeviceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1080,41 +1277,43 @@ func (m *UserLeftEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *UserLeftEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserLeftEvent.Merge(m, src)
+func (m *SelectOutputDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectOutputDeviceRequest.Merge(m, src)
 }
-func (m *UserLeftEvent) XXX_Size() int {
+func (m *SelectOutputDeviceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UserLeftEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserLeftEvent.DiscardUnknown(m)
+func (m *SelectOutputDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectOutputDeviceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserLeftEvent proto.InternalMessageInfo
+var xxx_messageInfo_SelectOutputDeviceRequest proto.InternalMessageInfo
 
-func (m *UserLeftEvent) GetUser() *UserInfo {
+func (m *SelectOutputDeviceRequest) GetDeviceId() string {
 	if m != nil {
-		return m.User
+		return m.DeviceId
 	}
-	return nil
+	return ""
 }
 
-type MessageReceivedEvent struct {
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+// PlaybackSettings is the volume applied to everything we play. A gain of 1 leaves the volume unchanged.
+type PlaybackSettings struct {
+	MasterGain float64 `protobuf:"fixed64,1,opt,name=master_gain,json=masterGain,proto3" json:"master_gain,omitempty"`
+	Muted      bool    `protobuf:"varint,2,opt,name=muted,proto3" json:"muted,omitempty"`
 }
 
-func (m *MessageReceivedEvent) Reset()         { *m = MessageReceivedEvent{} }
-func (m *MessageReceivedEvent) String() string { return proto.CompactTextString(m) }
-func (*MessageReceivedEvent) ProtoMessage()    {}
-func (*MessageReceivedEvent) Descriptor() ([]byte, []int) {
+func (m *PlaybackSettings) Reset()         { *m = PlaybackSettings{} }
+func (m *PlaybackSettings) String() string { return proto.CompactTextString(m) }
+func (*PlaybackSettings) ProtoMessage()    {}
+func (*PlaybackSettings) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{19}
 }
-func (m *MessageReceivedEvent) XXX_Unmarshal(b []byte) error {
+func (m *PlaybackSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageReceivedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PlaybackSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MessageReceivedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PlaybackSettings.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1124,41 +1323,48 @@ func (m *MessageReceivedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MessageReceivedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageReceivedEvent.Merge(m, src)
+func (m *PlaybackSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaybackSettings.Merge(m, src)
 }
-func (m *MessageReceivedEvent) XXX_Size() int {
+func (m *PlaybackSettings) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageReceivedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageReceivedEvent.DiscardUnknown(m)
+func (m *PlaybackSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaybackSettings.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageReceivedEvent proto.InternalMessageInfo
+var xxx_messageInfo_PlaybackSettings proto.InternalMessageInfo
 
-func (m *MessageReceivedEvent) GetMessage() *Message {
+func (m *PlaybackSettings) GetMasterGain() float64 {
 	if m != nil {
-		return m.Message
+		return m.MasterGain
 	}
-	return nil
+	return 0
 }
 
-type MessageSentEvent struct {
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+func (m *PlaybackSettings) GetMuted() bool {
+	if m != nil {
+		return m.Muted
+	}
+	return false
 }
 
-func (m *MessageSentEvent) Reset()         { *m = MessageSentEvent{} }
-func (m *MessageSentEvent) String() string { return proto.CompactTextString(m) }
-func (*MessageSentEvent) ProtoMessage()    {}
-func (*MessageSentEvent) Descriptor() ([]byte, []int) {
+type SetMasterGainRequest struct {
+	Gain float64 `protobuf:"fixed64,1,opt,name=gain,proto3" json:"gain,omitempty"`
+}
+
+func (m *SetMasterGainRequest) Reset()         { *m = SetMasterGainRequest{} }
+func (m *SetMasterGainRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMasterGainRequest) ProtoMessage()    {}
+func (*SetMasterGainRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{20}
 }
-func (m *MessageSentEvent) XXX_Unmarshal(b []byte) error {
+func (m *SetMasterGainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageSentEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetMasterGainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MessageSentEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetMasterGainRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1168,41 +1374,41 @@ func (m *MessageSentEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MessageSentEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageSentEvent.Merge(m, src)
+func (m *SetMasterGainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMasterGainRequest.Merge(m, src)
 }
-func (m *MessageSentEvent) XXX_Size() int {
+func (m *SetMasterGainRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageSentEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageSentEvent.DiscardUnknown(m)
+func (m *SetMasterGainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMasterGainRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageSentEvent proto.InternalMessageInfo
+var xxx_messageInfo_SetMasterGainRequest proto.InternalMessageInfo
 
-func (m *MessageSentEvent) GetMessage() *Message {
+func (m *SetMasterGainRequest) GetGain() float64 {
 	if m != nil {
-		return m.Message
+		return m.Gain
 	}
-	return nil
+	return 0
 }
 
-type ConnectToPeerRequestedEvent struct {
-	Request *ConnectToPeerRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+type SetMutedRequest struct {
+	Muted bool `protobuf:"varint,1,opt,name=muted,proto3" json:"muted,omitempty"`
 }
 
-func (m *ConnectToPeerRequestedEvent) Reset()         { *m = ConnectToPeerRequestedEvent{} }
-func (m *ConnectToPeerRequestedEvent) String() string { return proto.CompactTextString(m) }
-func (*ConnectToPeerRequestedEvent) ProtoMessage()    {}
-func (*ConnectToPeerRequestedEvent) Descriptor() ([]byte, []int) {
+func (m *SetMutedRequest) Reset()         { *m = SetMutedRequest{} }
+func (m *SetMutedRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMutedRequest) ProtoMessage()    {}
+func (*SetMutedRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e51414f019018a84, []int{21}
 }
-func (m *ConnectToPeerRequestedEvent) XXX_Unmarshal(b []byte) error {
+func (m *SetMutedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConnectToPeerRequestedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetMutedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConnectToPeerRequestedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetMutedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1212,1542 +1418,13730 @@ func (m *ConnectToPeerRequestedEvent) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ConnectToPeerRequestedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConnectToPeerRequestedEvent.Merge(m, src)
+func (m *SetMutedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMutedRequest.Merge(m, src)
 }
-func (m *ConnectToPeerRequestedEvent) XXX_Size() int {
+func (m *SetMutedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConnectToPeerRequestedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConnectToPeerRequestedEvent.DiscardUnknown(m)
+func (m *SetMutedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMutedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConnectToPeerRequestedEvent proto.InternalMessageInfo
+var xxx_messageInfo_SetMutedRequest proto.InternalMessageInfo
 
-func (m *ConnectToPeerRequestedEvent) GetRequest() *ConnectToPeerRequest {
+func (m *SetMutedRequest) GetMuted() bool {
 	if m != nil {
-		return m.Request
+		return m.Muted
 	}
-	return nil
+	return false
 }
 
-func init() {
-	proto.RegisterType((*UserInfo)(nil), "types.UserInfo")
-	proto.RegisterType((*Hello)(nil), "types.Hello")
-	proto.RegisterType((*Goodbye)(nil), "types.Goodbye")
-	proto.RegisterType((*Attachment)(nil), "types.Attachment")
-	proto.RegisterType((*AudioAttachment)(nil), "types.AudioAttachment")
-	proto.RegisterType((*Message)(nil), "types.Message")
-	proto.RegisterType((*InputDeviceInfo)(nil), "types.InputDeviceInfo")
-	proto.RegisterType((*InputDeviceList)(nil), "types.InputDeviceList")
-	proto.RegisterType((*BeginAudioRecordingRequest)(nil), "types.BeginAudioRecordingRequest")
-	proto.RegisterType((*StopAudioRecordingRequest)(nil), "types.StopAudioRecordingRequest")
-	proto.RegisterType((*PlayAudioRecordingRequest)(nil), "types.PlayAudioRecordingRequest")
-	proto.RegisterType((*ConnectToPeerRequest)(nil), "types.ConnectToPeerRequest")
-	proto.RegisterType((*ApiResponse)(nil), "types.ApiResponse")
-	proto.RegisterType((*ErrorResponse)(nil), "types.ErrorResponse")
-	proto.RegisterType((*OkResponse)(nil), "types.OkResponse")
-	proto.RegisterType((*BeginAudioRecordingResponse)(nil), "types.BeginAudioRecordingResponse")
-	proto.RegisterType((*Event)(nil), "types.Event")
-	proto.RegisterType((*UserJoinedEvent)(nil), "types.UserJoinedEvent")
-	proto.RegisterType((*UserLeftEvent)(nil), "types.UserLeftEvent")
-	proto.RegisterType((*MessageReceivedEvent)(nil), "types.MessageReceivedEvent")
-	proto.RegisterType((*MessageSentEvent)(nil), "types.MessageSentEvent")
-	proto.RegisterType((*ConnectToPeerRequestedEvent)(nil), "types.ConnectToPeerRequestedEvent")
+// scales the volume of live audio from one peer, so several people talking at once can be balanced
+type SetPeerGainRequest struct {
+	PeerId string  `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Gain   float64 `protobuf:"fixed64,2,opt,name=gain,proto3" json:"gain,omitempty"`
 }
 
-func init() { proto.RegisterFile("partyline.proto", fileDescriptor_e51414f019018a84) }
-
-var fileDescriptor_e51414f019018a84 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdb, 0x6e, 0xdb, 0x46,
-	0x13, 0xd6, 0xc1, 0x3a, 0x0d, 0x6d, 0x2b, 0xd9, 0xdf, 0xbf, 0xc3, 0x44, 0xa8, 0xe0, 0xb2, 0x28,
-	0xea, 0x00, 0x81, 0x50, 0xd8, 0x6d, 0x81, 0x00, 0x41, 0x13, 0x1f, 0x82, 0x4a, 0x85, 0x83, 0x06,
-	0xb4, 0x03, 0xf4, 0x8e, 0x58, 0x93, 0x23, 0x7b, 0x2b, 0x71, 0x97, 0xe5, 0x2e, 0x0d, 0x39, 0x0f,
-	0x51, 0xf4, 0x65, 0xfa, 0x02, 0xbd, 0xea, 0x65, 0x2e, 0x7b, 0x59, 0xd8, 0x2f, 0x52, 0xec, 0x72,
-	0x49, 0x59, 0xaa, 0x63, 0xb8, 0xbd, 0xe3, 0x7c, 0x33, 0xdf, 0xec, 0xcc, 0xb7, 0x33, 0x4b, 0xe8,
-	0x26, 0x34, 0x55, 0x97, 0x53, 0xc6, 0x71, 0x90, 0xa4, 0x42, 0x09, 0xd2, 0x50, 0x97, 0x09, 0x4a,
-	0xef, 0x25, 0xb4, 0xdf, 0x49, 0x4c, 0x47, 0x7c, 0x2c, 0xc8, 0x23, 0x68, 0x25, 0x88, 0x69, 0xc0,
-	0x22, 0xb7, 0xba, 0x55, 0xdd, 0xee, 0xf8, 0x4d, 0x6d, 0x8e, 0x22, 0xf2, 0x04, 0xda, 0x9c, 0x85,
-	0x13, 0x4e, 0x63, 0x74, 0x6b, 0xc6, 0x53, 0xda, 0xde, 0x33, 0x68, 0x0c, 0x71, 0x3a, 0x15, 0xe4,
-	0x33, 0x58, 0xc9, 0x24, 0xa6, 0x86, 0xea, 0xec, 0x74, 0x07, 0x26, 0xff, 0xa0, 0x48, 0xee, 0x1b,
-	0xa7, 0x37, 0x80, 0xd6, 0x77, 0x42, 0x44, 0xa7, 0x97, 0x78, 0xbf, 0xf8, 0x13, 0x80, 0x3d, 0xa5,
-	0x68, 0x78, 0x1e, 0x23, 0x57, 0x64, 0x1d, 0x6a, 0x65, 0x6d, 0x35, 0x16, 0x91, 0x01, 0x34, 0x68,
-	0x16, 0x31, 0xe1, 0xa2, 0xc9, 0xb1, 0x69, 0x73, 0xec, 0x69, 0x6c, 0x4e, 0x1b, 0x56, 0xfc, 0x3c,
-	0x6c, 0xbf, 0x09, 0x2b, 0x13, 0xc6, 0x23, 0x2f, 0x84, 0xee, 0x52, 0x0c, 0xd9, 0x80, 0x46, 0x28,
-	0x22, 0x0c, 0x6d, 0xf6, 0xdc, 0x20, 0x1e, 0xac, 0x8d, 0x53, 0x1a, 0x63, 0x20, 0xd9, 0x7b, 0x0c,
-	0x62, 0x69, 0xba, 0x6f, 0xf8, 0x8e, 0x01, 0x8f, 0xd9, 0x7b, 0x7c, 0x23, 0xc9, 0x26, 0x34, 0x8d,
-	0x29, 0xdd, 0xfa, 0x56, 0x7d, 0x7b, 0xd5, 0xb7, 0x96, 0xf7, 0x5b, 0x15, 0x5a, 0x6f, 0x50, 0x4a,
-	0x7a, 0x86, 0xe4, 0x0b, 0x68, 0xd2, 0x4c, 0x9d, 0x8b, 0x8f, 0x76, 0x6b, 0xdd, 0xe4, 0x29, 0x3c,
-	0x94, 0xc8, 0x55, 0x40, 0x55, 0xa0, 0x58, 0x8c, 0x41, 0xc6, 0xd9, 0xcc, 0x1c, 0x5a, 0xf7, 0xd7,
-	0xb5, 0x63, 0x4f, 0x9d, 0xb0, 0x18, 0xdf, 0x71, 0x36, 0x23, 0x9f, 0xc2, 0xaa, 0xc2, 0x99, 0x0a,
-	0x42, 0xc1, 0x15, 0x72, 0xe5, 0xd6, 0x4d, 0xe1, 0x8e, 0xc6, 0x0e, 0x72, 0x88, 0xec, 0x82, 0x43,
-	0xcb, 0x16, 0xa5, 0xbb, 0xb2, 0x55, 0xdf, 0x76, 0x76, 0x1e, 0x16, 0x2a, 0x95, 0x1e, 0xff, 0x66,
-	0x94, 0x47, 0xa1, 0x3b, 0xe2, 0x49, 0xa6, 0x0e, 0xf1, 0x82, 0x85, 0x68, 0x06, 0xa3, 0x07, 0x9d,
-	0xc8, 0x58, 0xf3, 0xd1, 0x68, 0xe7, 0xc0, 0x28, 0x22, 0x04, 0x56, 0x6e, 0x0c, 0x86, 0xf9, 0x26,
-	0x9f, 0x00, 0x30, 0x19, 0x44, 0x38, 0xa6, 0xd9, 0x34, 0xaf, 0xac, 0xed, 0x77, 0x98, 0x3c, 0xcc,
-	0x01, 0xef, 0x60, 0xe1, 0x88, 0x23, 0x26, 0x15, 0xf9, 0x12, 0x5a, 0x79, 0x46, 0xe9, 0x56, 0x4d,
-	0x99, 0xc5, 0x65, 0x2e, 0xd5, 0xe2, 0x17, 0x61, 0xde, 0x4b, 0x78, 0xb2, 0x8f, 0x67, 0x8c, 0x9b,
-	0x9b, 0xf4, 0x31, 0x14, 0x69, 0xc4, 0xf8, 0x99, 0x8f, 0x3f, 0x67, 0x28, 0x95, 0x56, 0x27, 0xa6,
-	0xb3, 0x20, 0xca, 0x52, 0xaa, 0x98, 0xe0, 0xb6, 0x6a, 0x27, 0xa6, 0xb3, 0x43, 0x0b, 0x79, 0xdf,
-	0xc2, 0xe3, 0x63, 0x25, 0x92, 0x8f, 0xf2, 0xd3, 0x02, 0x9b, 0x77, 0xed, 0x94, 0xd8, 0x28, 0xd2,
-	0xfc, 0xb7, 0x53, 0x7a, 0xf9, 0x9f, 0xf9, 0xcf, 0x61, 0xe3, 0x40, 0x70, 0x8e, 0xa1, 0x3a, 0x11,
-	0x6f, 0x11, 0xd3, 0x1b, 0x54, 0xb3, 0x86, 0x53, 0x11, 0x52, 0x65, 0x47, 0xa6, 0xe3, 0x3b, 0x1a,
-	0x3b, 0xca, 0x21, 0xef, 0xf7, 0x2a, 0x38, 0x7b, 0x09, 0xf3, 0x51, 0x26, 0x82, 0x4b, 0xbd, 0x4b,
-	0x35, 0x31, 0xb1, 0xb3, 0x55, 0xdc, 0xef, 0x0f, 0x93, 0xc2, 0x3d, 0xac, 0xf8, 0x35, 0x31, 0x21,
-	0xcf, 0xa0, 0x81, 0x69, 0x2a, 0x52, 0x73, 0x53, 0xce, 0xce, 0x86, 0x8d, 0x7b, 0xad, 0xb1, 0x1b,
-	0xa1, 0x79, 0x10, 0xf9, 0x11, 0xfe, 0x7f, 0xaa, 0xe5, 0x0d, 0xcc, 0xea, 0x04, 0x65, 0xe1, 0xe6,
-	0x36, 0x9d, 0x1d, 0xcf, 0xb2, 0x6f, 0xbd, 0x82, 0x32, 0xd7, 0xff, 0x4e, 0xff, 0xe9, 0xd6, 0x5b,
-	0x98, 0xa2, 0x4c, 0xbc, 0xa7, 0xb0, 0xb6, 0x70, 0x36, 0x71, 0xf5, 0x0c, 0x28, 0xca, 0xa6, 0xd2,
-	0xf6, 0x5c, 0x98, 0xde, 0x2a, 0xc0, 0xbc, 0x1d, 0xef, 0x15, 0xf4, 0xee, 0x38, 0xf6, 0x3e, 0xd2,
-	0xff, 0x52, 0x87, 0xc6, 0xeb, 0x0b, 0xbd, 0x22, 0x9f, 0xc3, 0xba, 0x5e, 0x34, 0xa9, 0x68, 0x9c,
-	0xe4, 0xdb, 0x56, 0x35, 0xdb, 0xb6, 0x56, 0xa2, 0x66, 0xd9, 0x9e, 0x83, 0xa3, 0xdf, 0xa3, 0xe0,
-	0x27, 0xc1, 0x38, 0x46, 0x4b, 0xef, 0x8d, 0xde, 0xe2, 0xef, 0x8d, 0xc3, 0xe4, 0x1c, 0x56, 0x7c,
-	0xc8, 0x4a, 0x88, 0xec, 0x42, 0xc7, 0x50, 0xa7, 0x38, 0x56, 0xee, 0x78, 0x41, 0x7a, 0x4d, 0x3c,
-	0xc2, 0xb1, 0x2a, 0x68, 0xed, 0xcc, 0x02, 0x64, 0x08, 0x0f, 0xe2, 0xfc, 0xed, 0xd0, 0xca, 0x23,
-	0xbb, 0xc0, 0xc8, 0x3d, 0x33, 0xdc, 0x9e, 0xe5, 0xda, 0xa7, 0xc5, 0xb7, 0xde, 0x22, 0x45, 0x37,
-	0x5e, 0xc4, 0xc9, 0x0b, 0x58, 0x2d, 0x32, 0xe9, 0x07, 0xc4, 0x3d, 0x37, 0x59, 0x1e, 0x2d, 0x66,
-	0x39, 0x46, 0x5e, 0x16, 0xe1, 0xc4, 0x73, 0x8c, 0x04, 0xf0, 0x38, 0xcc, 0x67, 0x34, 0x50, 0x22,
-	0x30, 0x63, 0x99, 0xe6, 0x63, 0x8a, 0x91, 0xcb, 0x16, 0x26, 0xe1, 0xb6, 0x59, 0x9e, 0xd7, 0xb5,
-	0x19, 0xde, 0xea, 0xde, 0x6f, 0x40, 0x1d, 0x2f, 0x94, 0xf7, 0x0d, 0x74, 0x97, 0x54, 0xbc, 0xdf,
-	0xff, 0xe1, 0x2b, 0x58, 0x5b, 0x10, 0xf1, 0x7e, 0xac, 0x57, 0xb0, 0x71, 0x9b, 0x7c, 0x64, 0x1b,
-	0x5a, 0xb6, 0x79, 0xcb, 0x5f, 0x5f, 0x12, 0xbb, 0x70, 0x7b, 0x2f, 0xe0, 0xc1, 0xb2, 0x74, 0xff,
-	0x82, 0x7d, 0x02, 0xbd, 0x3b, 0xd4, 0x22, 0x5f, 0x43, 0xcb, 0x8a, 0x6c, 0x13, 0xf5, 0xee, 0x90,
-	0xd8, 0x2f, 0x62, 0xf7, 0xdd, 0x3f, 0xae, 0xfa, 0xd5, 0x0f, 0x57, 0xfd, 0xea, 0x5f, 0x57, 0xfd,
-	0xea, 0xaf, 0xd7, 0xfd, 0xca, 0x87, 0xeb, 0x7e, 0xe5, 0xcf, 0xeb, 0x7e, 0xe5, 0xb4, 0x69, 0x7e,
-	0xf9, 0xbb, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xe3, 0x85, 0x54, 0x05, 0x08, 0x00, 0x00,
+func (m *SetPeerGainRequest) Reset()         { *m = SetPeerGainRequest{} }
+func (m *SetPeerGainRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPeerGainRequest) ProtoMessage()    {}
+func (*SetPeerGainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{22}
 }
-
-func (m *UserInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetPeerGainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPeerGainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPeerGainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *UserInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetPeerGainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPeerGainRequest.Merge(m, src)
+}
+func (m *SetPeerGainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPeerGainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPeerGainRequest.DiscardUnknown(m)
 }
 
-func (m *UserInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nickname) > 0 {
-		i -= len(m.Nickname)
-		copy(dAtA[i:], m.Nickname)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Nickname)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PeerId) > 0 {
-		i -= len(m.PeerId)
-		copy(dAtA[i:], m.PeerId)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.PeerId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_SetPeerGainRequest proto.InternalMessageInfo
+
+func (m *SetPeerGainRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Hello) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetPeerGainRequest) GetGain() float64 {
+	if m != nil {
+		return m.Gain
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Hello) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BeginAudioRecordingRequest struct {
+	// a Go duration string, e.g. "30s". The server's own limit applies if this is empty or longer.
+	MaxDuration string `protobuf:"bytes,1,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
 }
 
-func (m *Hello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.User != nil {
-		{
-			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
+func (m *BeginAudioRecordingRequest) Reset()         { *m = BeginAudioRecordingRequest{} }
+func (m *BeginAudioRecordingRequest) String() string { return proto.CompactTextString(m) }
+func (*BeginAudioRecordingRequest) ProtoMessage()    {}
+func (*BeginAudioRecordingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{23}
+}
+func (m *BeginAudioRecordingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeginAudioRecordingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeginAudioRecordingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BeginAudioRecordingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginAudioRecordingRequest.Merge(m, src)
+}
+func (m *BeginAudioRecordingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeginAudioRecordingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginAudioRecordingRequest.DiscardUnknown(m)
 }
 
-func (m *Goodbye) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BeginAudioRecordingRequest proto.InternalMessageInfo
+
+func (m *BeginAudioRecordingRequest) GetMaxDuration() string {
+	if m != nil {
+		return m.MaxDuration
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Goodbye) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BeginTalkRequest starts streaming live audio from the mic to every connected peer.
+// It's stopped like a recording, with a StopAudioRecordingRequest.
+type BeginTalkRequest struct {
+	// a Go duration string, e.g. "30s". The server's own limit applies if this is empty or longer.
+	MaxDuration string `protobuf:"bytes,1,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	// keep the audio as a recording afterwards, so it can also be sent as a voice message
+	SaveRecording bool `protobuf:"varint,2,opt,name=save_recording,json=saveRecording,proto3" json:"save_recording,omitempty"`
 }
 
-func (m *Goodbye) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.User != nil {
-		{
-			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
+func (m *BeginTalkRequest) Reset()         { *m = BeginTalkRequest{} }
+func (m *BeginTalkRequest) String() string { return proto.CompactTextString(m) }
+func (*BeginTalkRequest) ProtoMessage()    {}
+func (*BeginTalkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{24}
+}
+func (m *BeginTalkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeginTalkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeginTalkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Attachment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BeginTalkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginTalkRequest.Merge(m, src)
 }
-
-func (m *Attachment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BeginTalkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeginTalkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginTalkRequest.DiscardUnknown(m)
 }
 
-func (m *Attachment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Kind != nil {
-		{
-			size := m.Kind.Size()
-			i -= size
-			if _, err := m.Kind.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+var xxx_messageInfo_BeginTalkRequest proto.InternalMessageInfo
+
+func (m *BeginTalkRequest) GetMaxDuration() string {
+	if m != nil {
+		return m.MaxDuration
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *BeginTalkRequest) GetSaveRecording() bool {
+	if m != nil {
+		return m.SaveRecording
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *Attachment_Audio) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type StopAudioRecordingRequest struct {
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
 }
 
-func (m *Attachment_Audio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Audio != nil {
-		{
-			size, err := m.Audio.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
+func (m *StopAudioRecordingRequest) Reset()         { *m = StopAudioRecordingRequest{} }
+func (m *StopAudioRecordingRequest) String() string { return proto.CompactTextString(m) }
+func (*StopAudioRecordingRequest) ProtoMessage()    {}
+func (*StopAudioRecordingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{25}
+}
+func (m *StopAudioRecordingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopAudioRecordingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopAudioRecordingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xaa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *AudioAttachment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StopAudioRecordingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopAudioRecordingRequest.Merge(m, src)
+}
+func (m *StopAudioRecordingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopAudioRecordingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopAudioRecordingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopAudioRecordingRequest proto.InternalMessageInfo
+
+func (m *StopAudioRecordingRequest) GetRecordingId() string {
+	if m != nil {
+		return m.RecordingId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AudioAttachment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PlayAudioRecordingRequest struct {
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	// scales the volume of this playback, on top of the master gain. Zero (or unset) means 1, i.e. unchanged.
+	Gain float64 `protobuf:"fixed64,2,opt,name=gain,proto3" json:"gain,omitempty"`
+	// stop whatever's playing and clear the queue, instead of waiting for it to finish
+	Interrupt bool `protobuf:"varint,3,opt,name=interrupt,proto3" json:"interrupt,omitempty"`
 }
 
-func (m *AudioAttachment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Frames) > 0 {
-		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Frames[iNdEx])
-			copy(dAtA[i:], m.Frames[iNdEx])
-			i = encodeVarintPartyline(dAtA, i, uint64(len(m.Frames[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *PlayAudioRecordingRequest) Reset()         { *m = PlayAudioRecordingRequest{} }
+func (m *PlayAudioRecordingRequest) String() string { return proto.CompactTextString(m) }
+func (*PlayAudioRecordingRequest) ProtoMessage()    {}
+func (*PlayAudioRecordingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{26}
+}
+func (m *PlayAudioRecordingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlayAudioRecordingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlayAudioRecordingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.FrameSizeMs != 0 {
-		i = encodeVarintPartyline(dAtA, i, uint64(m.FrameSizeMs))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *PlayAudioRecordingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlayAudioRecordingRequest.Merge(m, src)
+}
+func (m *PlayAudioRecordingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlayAudioRecordingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlayAudioRecordingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlayAudioRecordingRequest proto.InternalMessageInfo
+
+func (m *PlayAudioRecordingRequest) GetRecordingId() string {
+	if m != nil {
+		return m.RecordingId
 	}
-	if len(m.Codec) > 0 {
-		i -= len(m.Codec)
-		copy(dAtA[i:], m.Codec)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Codec)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *PlayAudioRecordingRequest) GetGain() float64 {
+	if m != nil {
+		return m.Gain
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PlayAudioRecordingRequest) GetInterrupt() bool {
+	if m != nil {
+		return m.Interrupt
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PlaybackControlRequest pauses, resumes or stops a recording. An empty recording_id means the current one
+// (and for a stop request, everything that's queued too).
+type PlaybackControlRequest struct {
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attachments) > 0 {
-		for iNdEx := len(m.Attachments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attachments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPartyline(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.TextContent) > 0 {
-		i -= len(m.TextContent)
-		copy(dAtA[i:], m.TextContent)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.TextContent)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SentAtTimeUnix != 0 {
-		i = encodeVarintPartyline(dAtA, i, uint64(m.SentAtTimeUnix))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Author != nil {
-		{
-			size, err := m.Author.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
+func (m *PlaybackControlRequest) Reset()         { *m = PlaybackControlRequest{} }
+func (m *PlaybackControlRequest) String() string { return proto.CompactTextString(m) }
+func (*PlaybackControlRequest) ProtoMessage()    {}
+func (*PlaybackControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{27}
+}
+func (m *PlaybackControlRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlaybackControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlaybackControlRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PlaybackControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaybackControlRequest.Merge(m, src)
+}
+func (m *PlaybackControlRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlaybackControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaybackControlRequest.DiscardUnknown(m)
 }
 
-func (m *InputDeviceInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PlaybackControlRequest proto.InternalMessageInfo
+
+func (m *PlaybackControlRequest) GetRecordingId() string {
+	if m != nil {
+		return m.RecordingId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *InputDeviceInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SeekPlaybackRequest struct {
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	FrameIndex  uint32 `protobuf:"varint,2,opt,name=frame_index,json=frameIndex,proto3" json:"frame_index,omitempty"`
 }
 
-func (m *InputDeviceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsDefault {
-		i--
-		if m.IsDefault {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *SeekPlaybackRequest) Reset()         { *m = SeekPlaybackRequest{} }
+func (m *SeekPlaybackRequest) String() string { return proto.CompactTextString(m) }
+func (*SeekPlaybackRequest) ProtoMessage()    {}
+func (*SeekPlaybackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{28}
+}
+func (m *SeekPlaybackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeekPlaybackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SeekPlaybackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DeviceId) > 0 {
-		i -= len(m.DeviceId)
-		copy(dAtA[i:], m.DeviceId)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.DeviceId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *InputDeviceList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SeekPlaybackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeekPlaybackRequest.Merge(m, src)
 }
-
-func (m *InputDeviceList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SeekPlaybackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeekPlaybackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeekPlaybackRequest.DiscardUnknown(m)
 }
 
-func (m *InputDeviceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Devices) > 0 {
-		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Devices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPartyline(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_SeekPlaybackRequest proto.InternalMessageInfo
+
+func (m *SeekPlaybackRequest) GetRecordingId() string {
+	if m != nil {
+		return m.RecordingId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BeginAudioRecordingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SeekPlaybackRequest) GetFrameIndex() uint32 {
+	if m != nil {
+		return m.FrameIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BeginAudioRecordingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ConnectToPeerRequest struct {
+	// peer_locator is either a peer id or multiaddr with /p2p/ component
+	PeerLocator string `protobuf:"bytes,1,opt,name=peer_locator,json=peerLocator,proto3" json:"peer_locator,omitempty"`
 }
 
-func (m *BeginAudioRecordingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MaxDuration) > 0 {
-		i -= len(m.MaxDuration)
-		copy(dAtA[i:], m.MaxDuration)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.MaxDuration)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConnectToPeerRequest) Reset()         { *m = ConnectToPeerRequest{} }
+func (m *ConnectToPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*ConnectToPeerRequest) ProtoMessage()    {}
+func (*ConnectToPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{29}
+}
+func (m *ConnectToPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectToPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectToPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConnectToPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectToPeerRequest.Merge(m, src)
+}
+func (m *ConnectToPeerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectToPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectToPeerRequest.DiscardUnknown(m)
 }
 
-func (m *StopAudioRecordingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConnectToPeerRequest proto.InternalMessageInfo
+
+func (m *ConnectToPeerRequest) GetPeerLocator() string {
+	if m != nil {
+		return m.PeerLocator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *StopAudioRecordingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// JoinRoomRequest asks to find and connect to everyone else who joined a room with the same name.
+type JoinRoomRequest struct {
+	RoomName string `protobuf:"bytes,1,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
 }
 
-func (m *StopAudioRecordingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecordingId) > 0 {
-		i -= len(m.RecordingId)
-		copy(dAtA[i:], m.RecordingId)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
-		i--
-		dAtA[i] = 0xa
+func (m *JoinRoomRequest) Reset()         { *m = JoinRoomRequest{} }
+func (m *JoinRoomRequest) String() string { return proto.CompactTextString(m) }
+func (*JoinRoomRequest) ProtoMessage()    {}
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{30}
+}
+func (m *JoinRoomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinRoomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinRoomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *JoinRoomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinRoomRequest.Merge(m, src)
+}
+func (m *JoinRoomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinRoomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinRoomRequest.DiscardUnknown(m)
 }
 
-func (m *PlayAudioRecordingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JoinRoomRequest proto.InternalMessageInfo
+
+func (m *JoinRoomRequest) GetRoomName() string {
+	if m != nil {
+		return m.RoomName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PlayAudioRecordingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StoredMessage is a record in the local message database.
+type StoredMessage struct {
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// true if the message has a valid signature from its author. This isn't saved; it's
+	// checked again whenever the store is loaded.
+	Verified bool `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	// true for messages we sent
+	Sent         bool  `protobuf:"varint,3,opt,name=sent,proto3" json:"sent,omitempty"`
+	StoredAtUnix int64 `protobuf:"varint,4,opt,name=stored_at_unix,json=storedAtUnix,proto3" json:"stored_at_unix,omitempty"`
 }
 
-func (m *PlayAudioRecordingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecordingId) > 0 {
-		i -= len(m.RecordingId)
-		copy(dAtA[i:], m.RecordingId)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
-		i--
-		dAtA[i] = 0xa
+func (m *StoredMessage) Reset()         { *m = StoredMessage{} }
+func (m *StoredMessage) String() string { return proto.CompactTextString(m) }
+func (*StoredMessage) ProtoMessage()    {}
+func (*StoredMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{31}
+}
+func (m *StoredMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoredMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoredMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StoredMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoredMessage.Merge(m, src)
+}
+func (m *StoredMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoredMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoredMessage.DiscardUnknown(m)
 }
 
-func (m *ConnectToPeerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StoredMessage proto.InternalMessageInfo
+
+func (m *StoredMessage) GetMessage() *Message {
+	if m != nil {
+		return m.Message
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ConnectToPeerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StoredMessage) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
 }
 
-func (m *ConnectToPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PeerLocator) > 0 {
-		i -= len(m.PeerLocator)
-		copy(dAtA[i:], m.PeerLocator)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.PeerLocator)))
-		i--
-		dAtA[i] = 0xa
+func (m *StoredMessage) GetSent() bool {
+	if m != nil {
+		return m.Sent
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *ApiResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StoredMessage) GetStoredAtUnix() int64 {
+	if m != nil {
+		return m.StoredAtUnix
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ApiResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MessageHistoryRequest asks for a page of stored messages, newest first.
+type MessageHistoryRequest struct {
+	// if set, only messages stored before the message with this id and author are returned
+	BeforeMessageId    string `protobuf:"bytes,1,opt,name=before_message_id,json=beforeMessageId,proto3" json:"before_message_id,omitempty"`
+	Limit              int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	BeforeAuthorPeerId string `protobuf:"bytes,3,opt,name=before_author_peer_id,json=beforeAuthorPeerId,proto3" json:"before_author_peer_id,omitempty"`
 }
 
-func (m *ApiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Resp != nil {
-		{
-			size := m.Resp.Size()
-			i -= size
-			if _, err := m.Resp.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *MessageHistoryRequest) Reset()         { *m = MessageHistoryRequest{} }
+func (m *MessageHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*MessageHistoryRequest) ProtoMessage()    {}
+func (*MessageHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{32}
+}
+func (m *MessageHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ApiResponse_Ok) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MessageHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageHistoryRequest.Merge(m, src)
+}
+func (m *MessageHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *ApiResponse_Ok) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Ok != nil {
-		{
-			size, err := m.Ok.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MessageHistoryRequest proto.InternalMessageInfo
+
+func (m *MessageHistoryRequest) GetBeforeMessageId() string {
+	if m != nil {
+		return m.BeforeMessageId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *ApiResponse_Error) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *MessageHistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *ApiResponse_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Error != nil {
-		{
-			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *MessageHistoryRequest) GetBeforeAuthorPeerId() string {
+	if m != nil {
+		return m.BeforeAuthorPeerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *ApiResponse_BeginAudioRecording) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type MessageHistoryPage struct {
+	// oldest first
+	Messages []*StoredMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// true if there are older messages than the ones in this page
+	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 }
 
-func (m *ApiResponse_BeginAudioRecording) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BeginAudioRecording != nil {
-		{
-			size, err := m.BeginAudioRecording.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
+func (m *MessageHistoryPage) Reset()         { *m = MessageHistoryPage{} }
+func (m *MessageHistoryPage) String() string { return proto.CompactTextString(m) }
+func (*MessageHistoryPage) ProtoMessage()    {}
+func (*MessageHistoryPage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{33}
+}
+func (m *MessageHistoryPage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageHistoryPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageHistoryPage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *ErrorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MessageHistoryPage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageHistoryPage.Merge(m, src)
 }
-
-func (m *ErrorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MessageHistoryPage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageHistoryPage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageHistoryPage.DiscardUnknown(m)
 }
 
-func (m *ErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MessageHistoryPage proto.InternalMessageInfo
+
+func (m *MessageHistoryPage) GetMessages() []*StoredMessage {
+	if m != nil {
+		return m.Messages
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *OkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MessageHistoryPage) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *OkResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarkMessageReadRequest is sent by the UI once a message from another peer has been displayed.
+type MarkMessageReadRequest struct {
+	MessageId string    `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Author    *UserInfo `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
 }
 
-func (m *OkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MarkMessageReadRequest) Reset()         { *m = MarkMessageReadRequest{} }
+func (m *MarkMessageReadRequest) String() string { return proto.CompactTextString(m) }
+func (*MarkMessageReadRequest) ProtoMessage()    {}
+func (*MarkMessageReadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{34}
 }
-
-func (m *BeginAudioRecordingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarkMessageReadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkMessageReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkMessageReadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BeginAudioRecordingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarkMessageReadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkMessageReadRequest.Merge(m, src)
+}
+func (m *MarkMessageReadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkMessageReadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkMessageReadRequest.DiscardUnknown(m)
 }
 
-func (m *BeginAudioRecordingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecordingId) > 0 {
-		i -= len(m.RecordingId)
-		copy(dAtA[i:], m.RecordingId)
-		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MarkMessageReadRequest proto.InternalMessageInfo
+
+func (m *MarkMessageReadRequest) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Event) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarkMessageReadRequest) GetAuthor() *UserInfo {
+	if m != nil {
+		return m.Author
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Event) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ApiResponse struct {
+	// Types that are valid to be assigned to Resp:
+	//	*ApiResponse_Ok
+	//	*ApiResponse_Error
+	//	*ApiResponse_BeginAudioRecording
+	//	*ApiResponse_MessageHistory
+	//	*ApiResponse_ImportAudio
+	Resp isApiResponse_Resp `protobuf_oneof:"resp"`
 }
 
-func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Evt != nil {
-		{
-			size := m.Evt.Size()
-			i -= size
-			if _, err := m.Evt.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *ApiResponse) Reset()         { *m = ApiResponse{} }
+func (m *ApiResponse) String() string { return proto.CompactTextString(m) }
+func (*ApiResponse) ProtoMessage()    {}
+func (*ApiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{35}
+}
+func (m *ApiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TimestampUnix != 0 {
-		i = encodeVarintPartyline(dAtA, i, uint64(m.TimestampUnix))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ApiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiResponse.Merge(m, src)
+}
+func (m *ApiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiResponse.DiscardUnknown(m)
 }
 
-func (m *Event_UserJoined) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ApiResponse proto.InternalMessageInfo
+
+type isApiResponse_Resp interface {
+	isApiResponse_Resp()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *Event_UserJoined) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UserJoined != nil {
-		{
-			size, err := m.UserJoined.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xaa
-	}
-	return len(dAtA) - i, nil
+type ApiResponse_Ok struct {
+	Ok *OkResponse `protobuf:"bytes,1,opt,name=ok,proto3,oneof" json:"ok,omitempty"`
 }
-func (m *Event_UserLeft) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ApiResponse_Error struct {
+	Error *ErrorResponse `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
 }
-
-func (m *Event_UserLeft) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UserLeft != nil {
-		{
-			size, err := m.UserLeft.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xb2
-	}
-	return len(dAtA) - i, nil
+type ApiResponse_BeginAudioRecording struct {
+	BeginAudioRecording *BeginAudioRecordingResponse `protobuf:"bytes,3,opt,name=begin_audio_recording,json=beginAudioRecording,proto3,oneof" json:"begin_audio_recording,omitempty"`
 }
-func (m *Event_MessageReceived) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ApiResponse_MessageHistory struct {
+	MessageHistory *MessageHistoryPage `protobuf:"bytes,4,opt,name=message_history,json=messageHistory,proto3,oneof" json:"message_history,omitempty"`
+}
+type ApiResponse_ImportAudio struct {
+	ImportAudio *ImportAudioResponse `protobuf:"bytes,5,opt,name=import_audio,json=importAudio,proto3,oneof" json:"import_audio,omitempty"`
 }
 
-func (m *Event_MessageReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MessageReceived != nil {
-		{
-			size, err := m.MessageReceived.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xba
+func (*ApiResponse_Ok) isApiResponse_Resp()                  {}
+func (*ApiResponse_Error) isApiResponse_Resp()               {}
+func (*ApiResponse_BeginAudioRecording) isApiResponse_Resp() {}
+func (*ApiResponse_MessageHistory) isApiResponse_Resp()      {}
+func (*ApiResponse_ImportAudio) isApiResponse_Resp()         {}
+
+func (m *ApiResponse) GetResp() isApiResponse_Resp {
+	if m != nil {
+		return m.Resp
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Event_MessageSent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *Event_MessageSent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MessageSent != nil {
-		{
-			size, err := m.MessageSent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xc2
+func (m *ApiResponse) GetOk() *OkResponse {
+	if x, ok := m.GetResp().(*ApiResponse_Ok); ok {
+		return x.Ok
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Event_ConnectToPeerRequested) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *Event_ConnectToPeerRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ConnectToPeerRequested != nil {
-		{
-			size, err := m.ConnectToPeerRequested.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xca
+func (m *ApiResponse) GetError() *ErrorResponse {
+	if x, ok := m.GetResp().(*ApiResponse_Error); ok {
+		return x.Error
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *UserJoinedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *ApiResponse) GetBeginAudioRecording() *BeginAudioRecordingResponse {
+	if x, ok := m.GetResp().(*ApiResponse_BeginAudioRecording); ok {
+		return x.BeginAudioRecording
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UserJoinedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ApiResponse) GetMessageHistory() *MessageHistoryPage {
+	if x, ok := m.GetResp().(*ApiResponse_MessageHistory); ok {
+		return x.MessageHistory
+	}
+	return nil
 }
 
-func (m *UserJoinedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.User != nil {
-		{
-			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *ApiResponse) GetImportAudio() *ImportAudioResponse {
+	if x, ok := m.GetResp().(*ApiResponse_ImportAudio); ok {
+		return x.ImportAudio
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *UserLeftEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ApiResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ApiResponse_Ok)(nil),
+		(*ApiResponse_Error)(nil),
+		(*ApiResponse_BeginAudioRecording)(nil),
+		(*ApiResponse_MessageHistory)(nil),
+		(*ApiResponse_ImportAudio)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *UserLeftEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ErrorResponse struct {
+	Details string `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
 }
 
-func (m *UserLeftEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.User != nil {
-		{
-			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
+func (m *ErrorResponse) Reset()         { *m = ErrorResponse{} }
+func (m *ErrorResponse) String() string { return proto.CompactTextString(m) }
+func (*ErrorResponse) ProtoMessage()    {}
+func (*ErrorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{36}
+}
+func (m *ErrorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MessageReceivedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ErrorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorResponse.Merge(m, src)
 }
-
-func (m *MessageReceivedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ErrorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorResponse.DiscardUnknown(m)
 }
 
-func (m *MessageReceivedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ErrorResponse proto.InternalMessageInfo
+
+func (m *ErrorResponse) GetDetails() string {
+	if m != nil {
+		return m.Details
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MessageSentEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type OkResponse struct {
 }
 
-func (m *MessageSentEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OkResponse) Reset()         { *m = OkResponse{} }
+func (m *OkResponse) String() string { return proto.CompactTextString(m) }
+func (*OkResponse) ProtoMessage()    {}
+func (*OkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{37}
 }
-
-func (m *MessageSentEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
+func (m *OkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ConnectToPeerRequestedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OkResponse.Merge(m, src)
+}
+func (m *OkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OkResponse.DiscardUnknown(m)
 }
 
-func (m *ConnectToPeerRequestedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OkResponse proto.InternalMessageInfo
+
+type BeginAudioRecordingResponse struct {
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
 }
 
-func (m *ConnectToPeerRequestedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPartyline(dAtA, i, uint64(size))
+func (m *BeginAudioRecordingResponse) Reset()         { *m = BeginAudioRecordingResponse{} }
+func (m *BeginAudioRecordingResponse) String() string { return proto.CompactTextString(m) }
+func (*BeginAudioRecordingResponse) ProtoMessage()    {}
+func (*BeginAudioRecordingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{38}
+}
+func (m *BeginAudioRecordingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeginAudioRecordingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeginAudioRecordingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintPartyline(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPartyline(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *BeginAudioRecordingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginAudioRecordingResponse.Merge(m, src)
 }
-func (m *UserInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PeerId)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
-	}
-	l = len(m.Nickname)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
-	}
-	return n
+func (m *BeginAudioRecordingResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *Hello) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovPartyline(uint64(l))
-	}
-	return n
+func (m *BeginAudioRecordingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginAudioRecordingResponse.DiscardUnknown(m)
 }
 
-func (m *Goodbye) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovPartyline(uint64(l))
+var xxx_messageInfo_BeginAudioRecordingResponse proto.InternalMessageInfo
+
+func (m *BeginAudioRecordingResponse) GetRecordingId() string {
+	if m != nil {
+		return m.RecordingId
 	}
-	return n
+	return ""
 }
 
-func (m *Attachment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
-	}
-	if m.Kind != nil {
-		n += m.Kind.Size()
-	}
-	return n
+// ImportAudioResponse is returned after uploading an Ogg Opus file. The recording can then be
+// sent as an audio attachment.
+type ImportAudioResponse struct {
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	DurationMs  int64  `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
 }
 
-func (m *Attachment_Audio) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Audio != nil {
-		l = m.Audio.Size()
-		n += 2 + l + sovPartyline(uint64(l))
-	}
-	return n
+func (m *ImportAudioResponse) Reset()         { *m = ImportAudioResponse{} }
+func (m *ImportAudioResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportAudioResponse) ProtoMessage()    {}
+func (*ImportAudioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{39}
 }
-func (m *AudioAttachment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Codec)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
-	}
-	if m.FrameSizeMs != 0 {
-		n += 1 + sovPartyline(uint64(m.FrameSizeMs))
-	}
-	if len(m.Frames) > 0 {
-		for _, b := range m.Frames {
-			l = len(b)
-			n += 1 + l + sovPartyline(uint64(l))
+func (m *ImportAudioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportAudioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportAudioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ImportAudioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportAudioResponse.Merge(m, src)
+}
+func (m *ImportAudioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportAudioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportAudioResponse.DiscardUnknown(m)
 }
 
-func (m *Message) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Author != nil {
-		l = m.Author.Size()
-		n += 1 + l + sovPartyline(uint64(l))
-	}
-	if m.SentAtTimeUnix != 0 {
-		n += 1 + sovPartyline(uint64(m.SentAtTimeUnix))
-	}
-	l = len(m.TextContent)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
-	}
-	if len(m.Attachments) > 0 {
-		for _, e := range m.Attachments {
-			l = e.Size()
-			n += 1 + l + sovPartyline(uint64(l))
-		}
+var xxx_messageInfo_ImportAudioResponse proto.InternalMessageInfo
+
+func (m *ImportAudioResponse) GetRecordingId() string {
+	if m != nil {
+		return m.RecordingId
 	}
-	return n
+	return ""
 }
 
-func (m *InputDeviceInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DeviceId)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
-	}
-	if m.IsDefault {
-		n += 2
+func (m *ImportAudioResponse) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
 	}
-	return n
+	return 0
 }
 
-func (m *InputDeviceList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Devices) > 0 {
-		for _, e := range m.Devices {
-			l = e.Size()
-			n += 1 + l + sovPartyline(uint64(l))
+type Event struct {
+	TimestampUnix int64 `protobuf:"varint,1,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	// Types that are valid to be assigned to Evt:
+	//	*Event_UserJoined
+	//	*Event_UserLeft
+	//	*Event_MessageReceived
+	//	*Event_MessageSent
+	//	*Event_ConnectToPeerRequested
+	//	*Event_RelayAddressAcquired
+	//	*Event_NatTypeDetected
+	//	*Event_PeerDiscovered
+	//	*Event_JoinRoomRequested
+	//	*Event_RoomMemberFound
+	//	*Event_ConnectionStateChanged
+	//	*Event_AuthenticationFailed
+	//	*Event_ReceiptReceived
+	//	*Event_MessageReadRequested
+	//	*Event_OutboxStatusChanged
+	//	*Event_RecordingStopped
+	//	*Event_PlaybackStarted
+	//	*Event_PlaybackProgress
+	//	*Event_PlaybackFinished
+	//	*Event_LiveVoiceStarted
+	//	*Event_LiveVoiceEnded
+	Evt isEvent_Evt `protobuf_oneof:"evt"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{40}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
 
-func (m *BeginAudioRecordingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MaxDuration)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+type isEvent_Evt interface {
+	isEvent_Evt()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Event_UserJoined struct {
+	UserJoined *UserJoinedEvent `protobuf:"bytes,101,opt,name=user_joined,json=userJoined,proto3,oneof" json:"user_joined,omitempty"`
+}
+type Event_UserLeft struct {
+	UserLeft *UserLeftEvent `protobuf:"bytes,102,opt,name=user_left,json=userLeft,proto3,oneof" json:"user_left,omitempty"`
+}
+type Event_MessageReceived struct {
+	MessageReceived *MessageReceivedEvent `protobuf:"bytes,103,opt,name=message_received,json=messageReceived,proto3,oneof" json:"message_received,omitempty"`
+}
+type Event_MessageSent struct {
+	MessageSent *MessageSentEvent `protobuf:"bytes,104,opt,name=message_sent,json=messageSent,proto3,oneof" json:"message_sent,omitempty"`
+}
+type Event_ConnectToPeerRequested struct {
+	ConnectToPeerRequested *ConnectToPeerRequestedEvent `protobuf:"bytes,105,opt,name=connect_to_peer_requested,json=connectToPeerRequested,proto3,oneof" json:"connect_to_peer_requested,omitempty"`
+}
+type Event_RelayAddressAcquired struct {
+	RelayAddressAcquired *RelayAddressAcquiredEvent `protobuf:"bytes,106,opt,name=relay_address_acquired,json=relayAddressAcquired,proto3,oneof" json:"relay_address_acquired,omitempty"`
+}
+type Event_NatTypeDetected struct {
+	NatTypeDetected *NATTypeDetectedEvent `protobuf:"bytes,107,opt,name=nat_type_detected,json=natTypeDetected,proto3,oneof" json:"nat_type_detected,omitempty"`
+}
+type Event_PeerDiscovered struct {
+	PeerDiscovered *PeerDiscoveredEvent `protobuf:"bytes,108,opt,name=peer_discovered,json=peerDiscovered,proto3,oneof" json:"peer_discovered,omitempty"`
+}
+type Event_JoinRoomRequested struct {
+	JoinRoomRequested *JoinRoomRequestedEvent `protobuf:"bytes,109,opt,name=join_room_requested,json=joinRoomRequested,proto3,oneof" json:"join_room_requested,omitempty"`
+}
+type Event_RoomMemberFound struct {
+	RoomMemberFound *RoomMemberFoundEvent `protobuf:"bytes,110,opt,name=room_member_found,json=roomMemberFound,proto3,oneof" json:"room_member_found,omitempty"`
+}
+type Event_ConnectionStateChanged struct {
+	ConnectionStateChanged *ConnectionStateChangedEvent `protobuf:"bytes,111,opt,name=connection_state_changed,json=connectionStateChanged,proto3,oneof" json:"connection_state_changed,omitempty"`
+}
+type Event_AuthenticationFailed struct {
+	AuthenticationFailed *AuthenticationFailedEvent `protobuf:"bytes,112,opt,name=authentication_failed,json=authenticationFailed,proto3,oneof" json:"authentication_failed,omitempty"`
+}
+type Event_ReceiptReceived struct {
+	ReceiptReceived *ReceiptReceivedEvent `protobuf:"bytes,113,opt,name=receipt_received,json=receiptReceived,proto3,oneof" json:"receipt_received,omitempty"`
+}
+type Event_MessageReadRequested struct {
+	MessageReadRequested *MessageReadRequestedEvent `protobuf:"bytes,114,opt,name=message_read_requested,json=messageReadRequested,proto3,oneof" json:"message_read_requested,omitempty"`
+}
+type Event_OutboxStatusChanged struct {
+	OutboxStatusChanged *OutboxStatusChangedEvent `protobuf:"bytes,115,opt,name=outbox_status_changed,json=outboxStatusChanged,proto3,oneof" json:"outbox_status_changed,omitempty"`
+}
+type Event_RecordingStopped struct {
+	RecordingStopped *RecordingStoppedEvent `protobuf:"bytes,116,opt,name=recording_stopped,json=recordingStopped,proto3,oneof" json:"recording_stopped,omitempty"`
+}
+type Event_PlaybackStarted struct {
+	PlaybackStarted *PlaybackStartedEvent `protobuf:"bytes,117,opt,name=playback_started,json=playbackStarted,proto3,oneof" json:"playback_started,omitempty"`
+}
+type Event_PlaybackProgress struct {
+	PlaybackProgress *PlaybackProgressEvent `protobuf:"bytes,118,opt,name=playback_progress,json=playbackProgress,proto3,oneof" json:"playback_progress,omitempty"`
+}
+type Event_PlaybackFinished struct {
+	PlaybackFinished *PlaybackFinishedEvent `protobuf:"bytes,119,opt,name=playback_finished,json=playbackFinished,proto3,oneof" json:"playback_finished,omitempty"`
+}
+type Event_LiveVoiceStarted struct {
+	LiveVoiceStarted *LiveVoiceStartedEvent `protobuf:"bytes,120,opt,name=live_voice_started,json=liveVoiceStarted,proto3,oneof" json:"live_voice_started,omitempty"`
+}
+type Event_LiveVoiceEnded struct {
+	LiveVoiceEnded *LiveVoiceEndedEvent `protobuf:"bytes,121,opt,name=live_voice_ended,json=liveVoiceEnded,proto3,oneof" json:"live_voice_ended,omitempty"`
+}
+
+func (*Event_UserJoined) isEvent_Evt()             {}
+func (*Event_UserLeft) isEvent_Evt()               {}
+func (*Event_MessageReceived) isEvent_Evt()        {}
+func (*Event_MessageSent) isEvent_Evt()            {}
+func (*Event_ConnectToPeerRequested) isEvent_Evt() {}
+func (*Event_RelayAddressAcquired) isEvent_Evt()   {}
+func (*Event_NatTypeDetected) isEvent_Evt()        {}
+func (*Event_PeerDiscovered) isEvent_Evt()         {}
+func (*Event_JoinRoomRequested) isEvent_Evt()      {}
+func (*Event_RoomMemberFound) isEvent_Evt()        {}
+func (*Event_ConnectionStateChanged) isEvent_Evt() {}
+func (*Event_AuthenticationFailed) isEvent_Evt()   {}
+func (*Event_ReceiptReceived) isEvent_Evt()        {}
+func (*Event_MessageReadRequested) isEvent_Evt()   {}
+func (*Event_OutboxStatusChanged) isEvent_Evt()    {}
+func (*Event_RecordingStopped) isEvent_Evt()       {}
+func (*Event_PlaybackStarted) isEvent_Evt()        {}
+func (*Event_PlaybackProgress) isEvent_Evt()       {}
+func (*Event_PlaybackFinished) isEvent_Evt()       {}
+func (*Event_LiveVoiceStarted) isEvent_Evt()       {}
+func (*Event_LiveVoiceEnded) isEvent_Evt()         {}
+
+func (m *Event) GetEvt() isEvent_Evt {
+	if m != nil {
+		return m.Evt
 	}
-	return n
+	return nil
 }
 
-func (m *StopAudioRecordingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
 	}
-	var l int
-	_ = l
-	l = len(m.RecordingId)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
+	return 0
+}
+
+func (m *Event) GetUserJoined() *UserJoinedEvent {
+	if x, ok := m.GetEvt().(*Event_UserJoined); ok {
+		return x.UserJoined
 	}
-	return n
+	return nil
 }
 
-func (m *PlayAudioRecordingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) GetUserLeft() *UserLeftEvent {
+	if x, ok := m.GetEvt().(*Event_UserLeft); ok {
+		return x.UserLeft
 	}
-	var l int
-	_ = l
-	l = len(m.RecordingId)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
+	return nil
+}
+
+func (m *Event) GetMessageReceived() *MessageReceivedEvent {
+	if x, ok := m.GetEvt().(*Event_MessageReceived); ok {
+		return x.MessageReceived
 	}
-	return n
+	return nil
 }
 
-func (m *ConnectToPeerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) GetMessageSent() *MessageSentEvent {
+	if x, ok := m.GetEvt().(*Event_MessageSent); ok {
+		return x.MessageSent
 	}
-	var l int
-	_ = l
-	l = len(m.PeerLocator)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
+	return nil
+}
+
+func (m *Event) GetConnectToPeerRequested() *ConnectToPeerRequestedEvent {
+	if x, ok := m.GetEvt().(*Event_ConnectToPeerRequested); ok {
+		return x.ConnectToPeerRequested
 	}
-	return n
+	return nil
 }
 
-func (m *ApiResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) GetRelayAddressAcquired() *RelayAddressAcquiredEvent {
+	if x, ok := m.GetEvt().(*Event_RelayAddressAcquired); ok {
+		return x.RelayAddressAcquired
 	}
-	var l int
-	_ = l
-	if m.Resp != nil {
-		n += m.Resp.Size()
+	return nil
+}
+
+func (m *Event) GetNatTypeDetected() *NATTypeDetectedEvent {
+	if x, ok := m.GetEvt().(*Event_NatTypeDetected); ok {
+		return x.NatTypeDetected
 	}
-	return n
+	return nil
 }
 
-func (m *ApiResponse_Ok) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) GetPeerDiscovered() *PeerDiscoveredEvent {
+	if x, ok := m.GetEvt().(*Event_PeerDiscovered); ok {
+		return x.PeerDiscovered
 	}
-	var l int
-	_ = l
-	if m.Ok != nil {
-		l = m.Ok.Size()
-		n += 1 + l + sovPartyline(uint64(l))
+	return nil
+}
+
+func (m *Event) GetJoinRoomRequested() *JoinRoomRequestedEvent {
+	if x, ok := m.GetEvt().(*Event_JoinRoomRequested); ok {
+		return x.JoinRoomRequested
 	}
-	return n
+	return nil
 }
-func (m *ApiResponse_Error) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Event) GetRoomMemberFound() *RoomMemberFoundEvent {
+	if x, ok := m.GetEvt().(*Event_RoomMemberFound); ok {
+		return x.RoomMemberFound
 	}
-	var l int
-	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovPartyline(uint64(l))
+	return nil
+}
+
+func (m *Event) GetConnectionStateChanged() *ConnectionStateChangedEvent {
+	if x, ok := m.GetEvt().(*Event_ConnectionStateChanged); ok {
+		return x.ConnectionStateChanged
 	}
-	return n
+	return nil
 }
-func (m *ApiResponse_BeginAudioRecording) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Event) GetAuthenticationFailed() *AuthenticationFailedEvent {
+	if x, ok := m.GetEvt().(*Event_AuthenticationFailed); ok {
+		return x.AuthenticationFailed
 	}
-	var l int
-	_ = l
-	if m.BeginAudioRecording != nil {
-		l = m.BeginAudioRecording.Size()
-		n += 1 + l + sovPartyline(uint64(l))
+	return nil
+}
+
+func (m *Event) GetReceiptReceived() *ReceiptReceivedEvent {
+	if x, ok := m.GetEvt().(*Event_ReceiptReceived); ok {
+		return x.ReceiptReceived
 	}
-	return n
+	return nil
 }
-func (m *ErrorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Event) GetMessageReadRequested() *MessageReadRequestedEvent {
+	if x, ok := m.GetEvt().(*Event_MessageReadRequested); ok {
+		return x.MessageReadRequested
 	}
-	var l int
-	_ = l
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
+	return nil
+}
+
+func (m *Event) GetOutboxStatusChanged() *OutboxStatusChangedEvent {
+	if x, ok := m.GetEvt().(*Event_OutboxStatusChanged); ok {
+		return x.OutboxStatusChanged
 	}
-	return n
+	return nil
 }
 
-func (m *OkResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) GetRecordingStopped() *RecordingStoppedEvent {
+	if x, ok := m.GetEvt().(*Event_RecordingStopped); ok {
+		return x.RecordingStopped
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *BeginAudioRecordingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) GetPlaybackStarted() *PlaybackStartedEvent {
+	if x, ok := m.GetEvt().(*Event_PlaybackStarted); ok {
+		return x.PlaybackStarted
 	}
-	var l int
-	_ = l
-	l = len(m.RecordingId)
-	if l > 0 {
-		n += 1 + l + sovPartyline(uint64(l))
+	return nil
+}
+
+func (m *Event) GetPlaybackProgress() *PlaybackProgressEvent {
+	if x, ok := m.GetEvt().(*Event_PlaybackProgress); ok {
+		return x.PlaybackProgress
 	}
-	return n
+	return nil
 }
 
-func (m *Event) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) GetPlaybackFinished() *PlaybackFinishedEvent {
+	if x, ok := m.GetEvt().(*Event_PlaybackFinished); ok {
+		return x.PlaybackFinished
 	}
-	var l int
-	_ = l
-	if m.TimestampUnix != 0 {
-		n += 1 + sovPartyline(uint64(m.TimestampUnix))
-	}
-	if m.Evt != nil {
-		n += m.Evt.Size()
-	}
-	return n
+	return nil
 }
 
-func (m *Event_UserJoined) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UserJoined != nil {
-		l = m.UserJoined.Size()
-		n += 2 + l + sovPartyline(uint64(l))
+func (m *Event) GetLiveVoiceStarted() *LiveVoiceStartedEvent {
+	if x, ok := m.GetEvt().(*Event_LiveVoiceStarted); ok {
+		return x.LiveVoiceStarted
 	}
-	return n
+	return nil
 }
-func (m *Event_UserLeft) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UserLeft != nil {
-		l = m.UserLeft.Size()
-		n += 2 + l + sovPartyline(uint64(l))
+
+func (m *Event) GetLiveVoiceEnded() *LiveVoiceEndedEvent {
+	if x, ok := m.GetEvt().(*Event_LiveVoiceEnded); ok {
+		return x.LiveVoiceEnded
 	}
-	return n
+	return nil
 }
-func (m *Event_MessageReceived) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MessageReceived != nil {
-		l = m.MessageReceived.Size()
-		n += 2 + l + sovPartyline(uint64(l))
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Event_UserJoined)(nil),
+		(*Event_UserLeft)(nil),
+		(*Event_MessageReceived)(nil),
+		(*Event_MessageSent)(nil),
+		(*Event_ConnectToPeerRequested)(nil),
+		(*Event_RelayAddressAcquired)(nil),
+		(*Event_NatTypeDetected)(nil),
+		(*Event_PeerDiscovered)(nil),
+		(*Event_JoinRoomRequested)(nil),
+		(*Event_RoomMemberFound)(nil),
+		(*Event_ConnectionStateChanged)(nil),
+		(*Event_AuthenticationFailed)(nil),
+		(*Event_ReceiptReceived)(nil),
+		(*Event_MessageReadRequested)(nil),
+		(*Event_OutboxStatusChanged)(nil),
+		(*Event_RecordingStopped)(nil),
+		(*Event_PlaybackStarted)(nil),
+		(*Event_PlaybackProgress)(nil),
+		(*Event_PlaybackFinished)(nil),
+		(*Event_LiveVoiceStarted)(nil),
+		(*Event_LiveVoiceEnded)(nil),
 	}
-	return n
 }
-func (m *Event_MessageSent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MessageSent != nil {
-		l = m.MessageSent.Size()
-		n += 2 + l + sovPartyline(uint64(l))
-	}
-	return n
+
+type UserJoinedEvent struct {
+	User *UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
-func (m *Event_ConnectToPeerRequested) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ConnectToPeerRequested != nil {
-		l = m.ConnectToPeerRequested.Size()
-		n += 2 + l + sovPartyline(uint64(l))
-	}
-	return n
+
+func (m *UserJoinedEvent) Reset()         { *m = UserJoinedEvent{} }
+func (m *UserJoinedEvent) String() string { return proto.CompactTextString(m) }
+func (*UserJoinedEvent) ProtoMessage()    {}
+func (*UserJoinedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{41}
 }
-func (m *UserJoinedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UserJoinedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserJoinedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserJoinedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovPartyline(uint64(l))
+}
+func (m *UserJoinedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserJoinedEvent.Merge(m, src)
+}
+func (m *UserJoinedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserJoinedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserJoinedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserJoinedEvent proto.InternalMessageInfo
+
+func (m *UserJoinedEvent) GetUser() *UserInfo {
+	if m != nil {
+		return m.User
 	}
-	return n
+	return nil
 }
 
-func (m *UserLeftEvent) Size() (n int) {
-	if m == nil {
-		return 0
+type UserLeftEvent struct {
+	User *UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *UserLeftEvent) Reset()         { *m = UserLeftEvent{} }
+func (m *UserLeftEvent) String() string { return proto.CompactTextString(m) }
+func (*UserLeftEvent) ProtoMessage()    {}
+func (*UserLeftEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{42}
+}
+func (m *UserLeftEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLeftEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLeftEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovPartyline(uint64(l))
+}
+func (m *UserLeftEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLeftEvent.Merge(m, src)
+}
+func (m *UserLeftEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLeftEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLeftEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserLeftEvent proto.InternalMessageInfo
+
+func (m *UserLeftEvent) GetUser() *UserInfo {
+	if m != nil {
+		return m.User
 	}
-	return n
+	return nil
 }
 
-func (m *MessageReceivedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+type MessageReceivedEvent struct {
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// true if the message has a valid signature from its author
+	Verified  bool   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	MessageId string `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (m *MessageReceivedEvent) Reset()         { *m = MessageReceivedEvent{} }
+func (m *MessageReceivedEvent) String() string { return proto.CompactTextString(m) }
+func (*MessageReceivedEvent) ProtoMessage()    {}
+func (*MessageReceivedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{43}
+}
+func (m *MessageReceivedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageReceivedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageReceivedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovPartyline(uint64(l))
+}
+func (m *MessageReceivedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageReceivedEvent.Merge(m, src)
+}
+func (m *MessageReceivedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageReceivedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageReceivedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageReceivedEvent proto.InternalMessageInfo
+
+func (m *MessageReceivedEvent) GetMessage() *Message {
+	if m != nil {
+		return m.Message
 	}
-	return n
+	return nil
 }
 
-func (m *MessageSentEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessageReceivedEvent) GetVerified() bool {
+	if m != nil {
+		return m.Verified
 	}
-	var l int
-	_ = l
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovPartyline(uint64(l))
+	return false
+}
+
+func (m *MessageReceivedEvent) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
 	}
-	return n
+	return ""
 }
 
-func (m *ConnectToPeerRequestedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+type MessageSentEvent struct {
+	Message   *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	MessageId string   `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (m *MessageSentEvent) Reset()         { *m = MessageSentEvent{} }
+func (m *MessageSentEvent) String() string { return proto.CompactTextString(m) }
+func (*MessageSentEvent) ProtoMessage()    {}
+func (*MessageSentEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{44}
+}
+func (m *MessageSentEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageSentEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageSentEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
+}
+func (m *MessageSentEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageSentEvent.Merge(m, src)
+}
+func (m *MessageSentEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageSentEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageSentEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageSentEvent proto.InternalMessageInfo
+
+func (m *MessageSentEvent) GetMessage() *Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *MessageSentEvent) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+type ConnectToPeerRequestedEvent struct {
+	Request *ConnectToPeerRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *ConnectToPeerRequestedEvent) Reset()         { *m = ConnectToPeerRequestedEvent{} }
+func (m *ConnectToPeerRequestedEvent) String() string { return proto.CompactTextString(m) }
+func (*ConnectToPeerRequestedEvent) ProtoMessage()    {}
+func (*ConnectToPeerRequestedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{45}
+}
+func (m *ConnectToPeerRequestedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectToPeerRequestedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectToPeerRequestedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectToPeerRequestedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectToPeerRequestedEvent.Merge(m, src)
+}
+func (m *ConnectToPeerRequestedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectToPeerRequestedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectToPeerRequestedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectToPeerRequestedEvent proto.InternalMessageInfo
+
+func (m *ConnectToPeerRequestedEvent) GetRequest() *ConnectToPeerRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+// RelayAddressAcquiredEvent is sent when we get a circuit relay address that other peers can dial us on.
+type RelayAddressAcquiredEvent struct {
+	RelayAddr string `protobuf:"bytes,1,opt,name=relay_addr,json=relayAddr,proto3" json:"relay_addr,omitempty"`
+}
+
+func (m *RelayAddressAcquiredEvent) Reset()         { *m = RelayAddressAcquiredEvent{} }
+func (m *RelayAddressAcquiredEvent) String() string { return proto.CompactTextString(m) }
+func (*RelayAddressAcquiredEvent) ProtoMessage()    {}
+func (*RelayAddressAcquiredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{46}
+}
+func (m *RelayAddressAcquiredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayAddressAcquiredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayAddressAcquiredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayAddressAcquiredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayAddressAcquiredEvent.Merge(m, src)
+}
+func (m *RelayAddressAcquiredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayAddressAcquiredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayAddressAcquiredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayAddressAcquiredEvent proto.InternalMessageInfo
+
+func (m *RelayAddressAcquiredEvent) GetRelayAddr() string {
+	if m != nil {
+		return m.RelayAddr
+	}
+	return ""
+}
+
+// NATTypeDetectedEvent is sent when libp2p figures out what kind of NAT we're behind for a transport.
+type NATTypeDetectedEvent struct {
+	TransportProtocol     string `protobuf:"bytes,1,opt,name=transport_protocol,json=transportProtocol,proto3" json:"transport_protocol,omitempty"`
+	NatDeviceType         string `protobuf:"bytes,2,opt,name=nat_device_type,json=natDeviceType,proto3" json:"nat_device_type,omitempty"`
+	HolePunchingSupported bool   `protobuf:"varint,3,opt,name=hole_punching_supported,json=holePunchingSupported,proto3" json:"hole_punching_supported,omitempty"`
+}
+
+func (m *NATTypeDetectedEvent) Reset()         { *m = NATTypeDetectedEvent{} }
+func (m *NATTypeDetectedEvent) String() string { return proto.CompactTextString(m) }
+func (*NATTypeDetectedEvent) ProtoMessage()    {}
+func (*NATTypeDetectedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{47}
+}
+func (m *NATTypeDetectedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NATTypeDetectedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NATTypeDetectedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NATTypeDetectedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NATTypeDetectedEvent.Merge(m, src)
+}
+func (m *NATTypeDetectedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *NATTypeDetectedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_NATTypeDetectedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NATTypeDetectedEvent proto.InternalMessageInfo
+
+func (m *NATTypeDetectedEvent) GetTransportProtocol() string {
+	if m != nil {
+		return m.TransportProtocol
+	}
+	return ""
+}
+
+func (m *NATTypeDetectedEvent) GetNatDeviceType() string {
+	if m != nil {
+		return m.NatDeviceType
+	}
+	return ""
+}
+
+func (m *NATTypeDetectedEvent) GetHolePunchingSupported() bool {
+	if m != nil {
+		return m.HolePunchingSupported
+	}
+	return false
+}
+
+// PeerDiscoveredEvent is sent when we find another party-line user on the local network.
+// The user's nickname is empty until we've exchanged Hello messages with them.
+type PeerDiscoveredEvent struct {
+	User  *UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Addrs []string  `protobuf:"bytes,2,rep,name=addrs,proto3" json:"addrs,omitempty"`
+}
+
+func (m *PeerDiscoveredEvent) Reset()         { *m = PeerDiscoveredEvent{} }
+func (m *PeerDiscoveredEvent) String() string { return proto.CompactTextString(m) }
+func (*PeerDiscoveredEvent) ProtoMessage()    {}
+func (*PeerDiscoveredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{48}
+}
+func (m *PeerDiscoveredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerDiscoveredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerDiscoveredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerDiscoveredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerDiscoveredEvent.Merge(m, src)
+}
+func (m *PeerDiscoveredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerDiscoveredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerDiscoveredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerDiscoveredEvent proto.InternalMessageInfo
+
+func (m *PeerDiscoveredEvent) GetUser() *UserInfo {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *PeerDiscoveredEvent) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+type JoinRoomRequestedEvent struct {
+	Request *JoinRoomRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *JoinRoomRequestedEvent) Reset()         { *m = JoinRoomRequestedEvent{} }
+func (m *JoinRoomRequestedEvent) String() string { return proto.CompactTextString(m) }
+func (*JoinRoomRequestedEvent) ProtoMessage()    {}
+func (*JoinRoomRequestedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{49}
+}
+func (m *JoinRoomRequestedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinRoomRequestedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinRoomRequestedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinRoomRequestedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinRoomRequestedEvent.Merge(m, src)
+}
+func (m *JoinRoomRequestedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinRoomRequestedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinRoomRequestedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinRoomRequestedEvent proto.InternalMessageInfo
+
+func (m *JoinRoomRequestedEvent) GetRequest() *JoinRoomRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+// RoomMemberFoundEvent is sent when we find a new peer in a room we've joined.
+type RoomMemberFoundEvent struct {
+	RoomName string    `protobuf:"bytes,1,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	User     *UserInfo `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *RoomMemberFoundEvent) Reset()         { *m = RoomMemberFoundEvent{} }
+func (m *RoomMemberFoundEvent) String() string { return proto.CompactTextString(m) }
+func (*RoomMemberFoundEvent) ProtoMessage()    {}
+func (*RoomMemberFoundEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{50}
+}
+func (m *RoomMemberFoundEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoomMemberFoundEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoomMemberFoundEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoomMemberFoundEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoomMemberFoundEvent.Merge(m, src)
+}
+func (m *RoomMemberFoundEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoomMemberFoundEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoomMemberFoundEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoomMemberFoundEvent proto.InternalMessageInfo
+
+func (m *RoomMemberFoundEvent) GetRoomName() string {
+	if m != nil {
+		return m.RoomName
+	}
+	return ""
+}
+
+func (m *RoomMemberFoundEvent) GetUser() *UserInfo {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type ConnectionStateChangedEvent struct {
+	// user only has a peer_id until we've exchanged Hello messages
+	User  *UserInfo       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	State ConnectionState `protobuf:"varint,2,opt,name=state,proto3,enum=types.ConnectionState" json:"state,omitempty"`
+}
+
+func (m *ConnectionStateChangedEvent) Reset()         { *m = ConnectionStateChangedEvent{} }
+func (m *ConnectionStateChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*ConnectionStateChangedEvent) ProtoMessage()    {}
+func (*ConnectionStateChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{51}
+}
+func (m *ConnectionStateChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionStateChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionStateChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionStateChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionStateChangedEvent.Merge(m, src)
+}
+func (m *ConnectionStateChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionStateChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionStateChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionStateChangedEvent proto.InternalMessageInfo
+
+func (m *ConnectionStateChangedEvent) GetUser() *UserInfo {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *ConnectionStateChangedEvent) GetState() ConnectionState {
+	if m != nil {
+		return m.State
+	}
+	return ConnectionState_DISCONNECTED
+}
+
+// AuthenticationFailedEvent is sent when a peer sends a Hello or Message claiming to be someone they're not.
+// The offending Hello or Message is dropped.
+type AuthenticationFailedEvent struct {
+	// the peer that actually sent the data, according to the libp2p connection
+	SenderPeerId string `protobuf:"bytes,1,opt,name=sender_peer_id,json=senderPeerId,proto3" json:"sender_peer_id,omitempty"`
+	// who they claimed to be
+	ClaimedUser *UserInfo `protobuf:"bytes,2,opt,name=claimed_user,json=claimedUser,proto3" json:"claimed_user,omitempty"`
+	Reason      string    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *AuthenticationFailedEvent) Reset()         { *m = AuthenticationFailedEvent{} }
+func (m *AuthenticationFailedEvent) String() string { return proto.CompactTextString(m) }
+func (*AuthenticationFailedEvent) ProtoMessage()    {}
+func (*AuthenticationFailedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{52}
+}
+func (m *AuthenticationFailedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticationFailedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthenticationFailedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthenticationFailedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticationFailedEvent.Merge(m, src)
+}
+func (m *AuthenticationFailedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticationFailedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticationFailedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticationFailedEvent proto.InternalMessageInfo
+
+func (m *AuthenticationFailedEvent) GetSenderPeerId() string {
+	if m != nil {
+		return m.SenderPeerId
+	}
+	return ""
+}
+
+func (m *AuthenticationFailedEvent) GetClaimedUser() *UserInfo {
+	if m != nil {
+		return m.ClaimedUser
+	}
+	return nil
+}
+
+func (m *AuthenticationFailedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ReceiptReceivedEvent is sent when a peer tells us they received or read one of our messages.
+type ReceiptReceivedEvent struct {
+	Receipt *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (m *ReceiptReceivedEvent) Reset()         { *m = ReceiptReceivedEvent{} }
+func (m *ReceiptReceivedEvent) String() string { return proto.CompactTextString(m) }
+func (*ReceiptReceivedEvent) ProtoMessage()    {}
+func (*ReceiptReceivedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{53}
+}
+func (m *ReceiptReceivedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptReceivedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptReceivedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptReceivedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptReceivedEvent.Merge(m, src)
+}
+func (m *ReceiptReceivedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptReceivedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptReceivedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptReceivedEvent proto.InternalMessageInfo
+
+func (m *ReceiptReceivedEvent) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+type MessageReadRequestedEvent struct {
+	Request *MarkMessageReadRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *MessageReadRequestedEvent) Reset()         { *m = MessageReadRequestedEvent{} }
+func (m *MessageReadRequestedEvent) String() string { return proto.CompactTextString(m) }
+func (*MessageReadRequestedEvent) ProtoMessage()    {}
+func (*MessageReadRequestedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{54}
+}
+func (m *MessageReadRequestedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageReadRequestedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageReadRequestedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageReadRequestedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageReadRequestedEvent.Merge(m, src)
+}
+func (m *MessageReadRequestedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageReadRequestedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageReadRequestedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageReadRequestedEvent proto.InternalMessageInfo
+
+func (m *MessageReadRequestedEvent) GetRequest() *MarkMessageReadRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type OutboxStatusChangedEvent struct {
+	MessageId string       `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Recipient *UserInfo    `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Status    OutboxStatus `protobuf:"varint,3,opt,name=status,proto3,enum=types.OutboxStatus" json:"status,omitempty"`
+}
+
+func (m *OutboxStatusChangedEvent) Reset()         { *m = OutboxStatusChangedEvent{} }
+func (m *OutboxStatusChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*OutboxStatusChangedEvent) ProtoMessage()    {}
+func (*OutboxStatusChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{55}
+}
+func (m *OutboxStatusChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboxStatusChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboxStatusChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutboxStatusChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboxStatusChangedEvent.Merge(m, src)
+}
+func (m *OutboxStatusChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboxStatusChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboxStatusChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboxStatusChangedEvent proto.InternalMessageInfo
+
+func (m *OutboxStatusChangedEvent) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *OutboxStatusChangedEvent) GetRecipient() *UserInfo {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *OutboxStatusChangedEvent) GetStatus() OutboxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OutboxStatus_OUTBOX_QUEUED
+}
+
+// RecordingStoppedEvent is sent when a recording is stopped automatically because it reached its max duration.
+type RecordingStoppedEvent struct {
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+}
+
+func (m *RecordingStoppedEvent) Reset()         { *m = RecordingStoppedEvent{} }
+func (m *RecordingStoppedEvent) String() string { return proto.CompactTextString(m) }
+func (*RecordingStoppedEvent) ProtoMessage()    {}
+func (*RecordingStoppedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{56}
+}
+func (m *RecordingStoppedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordingStoppedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordingStoppedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordingStoppedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordingStoppedEvent.Merge(m, src)
+}
+func (m *RecordingStoppedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordingStoppedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordingStoppedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordingStoppedEvent proto.InternalMessageInfo
+
+func (m *RecordingStoppedEvent) GetRecordingId() string {
+	if m != nil {
+		return m.RecordingId
+	}
+	return ""
+}
+
+type PlaybackStartedEvent struct {
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	TotalFrames uint32 `protobuf:"varint,2,opt,name=total_frames,json=totalFrames,proto3" json:"total_frames,omitempty"`
+	DurationMs  int64  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (m *PlaybackStartedEvent) Reset()         { *m = PlaybackStartedEvent{} }
+func (m *PlaybackStartedEvent) String() string { return proto.CompactTextString(m) }
+func (*PlaybackStartedEvent) ProtoMessage()    {}
+func (*PlaybackStartedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{57}
+}
+func (m *PlaybackStartedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlaybackStartedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlaybackStartedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlaybackStartedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaybackStartedEvent.Merge(m, src)
+}
+func (m *PlaybackStartedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlaybackStartedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaybackStartedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaybackStartedEvent proto.InternalMessageInfo
+
+func (m *PlaybackStartedEvent) GetRecordingId() string {
+	if m != nil {
+		return m.RecordingId
+	}
+	return ""
+}
+
+func (m *PlaybackStartedEvent) GetTotalFrames() uint32 {
+	if m != nil {
+		return m.TotalFrames
+	}
+	return 0
+}
+
+func (m *PlaybackStartedEvent) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+// PlaybackProgressEvent is sent every so often while a recording plays, and when it's paused, resumed or seeked.
+type PlaybackProgressEvent struct {
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	FrameIndex  uint32 `protobuf:"varint,2,opt,name=frame_index,json=frameIndex,proto3" json:"frame_index,omitempty"`
+	TotalFrames uint32 `protobuf:"varint,3,opt,name=total_frames,json=totalFrames,proto3" json:"total_frames,omitempty"`
+	Paused      bool   `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *PlaybackProgressEvent) Reset()         { *m = PlaybackProgressEvent{} }
+func (m *PlaybackProgressEvent) String() string { return proto.CompactTextString(m) }
+func (*PlaybackProgressEvent) ProtoMessage()    {}
+func (*PlaybackProgressEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{58}
+}
+func (m *PlaybackProgressEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlaybackProgressEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlaybackProgressEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlaybackProgressEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaybackProgressEvent.Merge(m, src)
+}
+func (m *PlaybackProgressEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlaybackProgressEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaybackProgressEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaybackProgressEvent proto.InternalMessageInfo
+
+func (m *PlaybackProgressEvent) GetRecordingId() string {
+	if m != nil {
+		return m.RecordingId
+	}
+	return ""
+}
+
+func (m *PlaybackProgressEvent) GetFrameIndex() uint32 {
+	if m != nil {
+		return m.FrameIndex
+	}
+	return 0
+}
+
+func (m *PlaybackProgressEvent) GetTotalFrames() uint32 {
+	if m != nil {
+		return m.TotalFrames
+	}
+	return 0
+}
+
+func (m *PlaybackProgressEvent) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type PlaybackFinishedEvent struct {
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	// true if the recording was stopped (or removed from the queue) before it got to the end
+	Stopped bool `protobuf:"varint,2,opt,name=stopped,proto3" json:"stopped,omitempty"`
+}
+
+func (m *PlaybackFinishedEvent) Reset()         { *m = PlaybackFinishedEvent{} }
+func (m *PlaybackFinishedEvent) String() string { return proto.CompactTextString(m) }
+func (*PlaybackFinishedEvent) ProtoMessage()    {}
+func (*PlaybackFinishedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{59}
+}
+func (m *PlaybackFinishedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlaybackFinishedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlaybackFinishedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlaybackFinishedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaybackFinishedEvent.Merge(m, src)
+}
+func (m *PlaybackFinishedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlaybackFinishedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaybackFinishedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaybackFinishedEvent proto.InternalMessageInfo
+
+func (m *PlaybackFinishedEvent) GetRecordingId() string {
+	if m != nil {
+		return m.RecordingId
+	}
+	return ""
+}
+
+func (m *PlaybackFinishedEvent) GetStopped() bool {
+	if m != nil {
+		return m.Stopped
+	}
+	return false
+}
+
+// VoiceFrame is one opus frame of live push-to-talk audio. Each talk is sent on its own voice protocol stream.
+type VoiceFrame struct {
+	TalkId    string `protobuf:"bytes,1,opt,name=talk_id,json=talkId,proto3" json:"talk_id,omitempty"`
+	Seq       uint32 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	OpusFrame []byte `protobuf:"bytes,3,opt,name=opus_frame,json=opusFrame,proto3" json:"opus_frame,omitempty"`
+	// set on the last frame of a talk, which has no audio
+	End bool `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	// when the frame was captured, in milliseconds since the talk started
+	TimestampMs uint32 `protobuf:"varint,5,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+}
+
+func (m *VoiceFrame) Reset()         { *m = VoiceFrame{} }
+func (m *VoiceFrame) String() string { return proto.CompactTextString(m) }
+func (*VoiceFrame) ProtoMessage()    {}
+func (*VoiceFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{60}
+}
+func (m *VoiceFrame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoiceFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoiceFrame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoiceFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoiceFrame.Merge(m, src)
+}
+func (m *VoiceFrame) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoiceFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoiceFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoiceFrame proto.InternalMessageInfo
+
+func (m *VoiceFrame) GetTalkId() string {
+	if m != nil {
+		return m.TalkId
+	}
+	return ""
+}
+
+func (m *VoiceFrame) GetSeq() uint32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *VoiceFrame) GetOpusFrame() []byte {
+	if m != nil {
+		return m.OpusFrame
+	}
+	return nil
+}
+
+func (m *VoiceFrame) GetEnd() bool {
+	if m != nil {
+		return m.End
+	}
+	return false
+}
+
+func (m *VoiceFrame) GetTimestampMs() uint32 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+// LiveStreamStats describe how well live audio from a peer is arriving.
+type LiveStreamStats struct {
+	PeerId string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	TalkId string `protobuf:"bytes,2,opt,name=talk_id,json=talkId,proto3" json:"talk_id,omitempty"`
+	// false once the talk has ended
+	Active bool `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	// frames that arrived in time to be played
+	Received uint64 `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+	// frames that arrived after their turn to play had passed
+	Late uint64 `protobuf:"varint,5,opt,name=late,proto3" json:"late,omitempty"`
+	// frames that didn't arrive in time, including late ones
+	Lost uint64 `protobuf:"varint,6,opt,name=lost,proto3" json:"lost,omitempty"`
+	// lost frames that were rebuilt from the next frame's FEC data
+	Recovered uint64 `protobuf:"varint,7,opt,name=recovered,proto3" json:"recovered,omitempty"`
+	// lost frames that were filled in by packet loss concealment
+	Concealed uint64 `protobuf:"varint,8,opt,name=concealed,proto3" json:"concealed,omitempty"`
+	// the smoothed variation in arrival times, as in RFC 3550
+	JitterMs       float64 `protobuf:"fixed64,9,opt,name=jitter_ms,json=jitterMs,proto3" json:"jitter_ms,omitempty"`
+	BufferedFrames uint32  `protobuf:"varint,10,opt,name=buffered_frames,json=bufferedFrames,proto3" json:"buffered_frames,omitempty"`
+}
+
+func (m *LiveStreamStats) Reset()         { *m = LiveStreamStats{} }
+func (m *LiveStreamStats) String() string { return proto.CompactTextString(m) }
+func (*LiveStreamStats) ProtoMessage()    {}
+func (*LiveStreamStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{61}
+}
+func (m *LiveStreamStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiveStreamStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiveStreamStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiveStreamStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiveStreamStats.Merge(m, src)
+}
+func (m *LiveStreamStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiveStreamStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiveStreamStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiveStreamStats proto.InternalMessageInfo
+
+func (m *LiveStreamStats) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *LiveStreamStats) GetTalkId() string {
+	if m != nil {
+		return m.TalkId
+	}
+	return ""
+}
+
+func (m *LiveStreamStats) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *LiveStreamStats) GetReceived() uint64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *LiveStreamStats) GetLate() uint64 {
+	if m != nil {
+		return m.Late
+	}
+	return 0
+}
+
+func (m *LiveStreamStats) GetLost() uint64 {
+	if m != nil {
+		return m.Lost
+	}
+	return 0
+}
+
+func (m *LiveStreamStats) GetRecovered() uint64 {
+	if m != nil {
+		return m.Recovered
+	}
+	return 0
+}
+
+func (m *LiveStreamStats) GetConcealed() uint64 {
+	if m != nil {
+		return m.Concealed
+	}
+	return 0
+}
+
+func (m *LiveStreamStats) GetJitterMs() float64 {
+	if m != nil {
+		return m.JitterMs
+	}
+	return 0
+}
+
+func (m *LiveStreamStats) GetBufferedFrames() uint32 {
+	if m != nil {
+		return m.BufferedFrames
+	}
+	return 0
+}
+
+type LiveStreamStatsList struct {
+	Streams []*LiveStreamStats `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+}
+
+func (m *LiveStreamStatsList) Reset()         { *m = LiveStreamStatsList{} }
+func (m *LiveStreamStatsList) String() string { return proto.CompactTextString(m) }
+func (*LiveStreamStatsList) ProtoMessage()    {}
+func (*LiveStreamStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{62}
+}
+func (m *LiveStreamStatsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiveStreamStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiveStreamStatsList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiveStreamStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiveStreamStatsList.Merge(m, src)
+}
+func (m *LiveStreamStatsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiveStreamStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiveStreamStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiveStreamStatsList proto.InternalMessageInfo
+
+func (m *LiveStreamStatsList) GetStreams() []*LiveStreamStats {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+// LiveVoiceStartedEvent is sent when a peer starts talking.
+type LiveVoiceStartedEvent struct {
+	TalkId string    `protobuf:"bytes,1,opt,name=talk_id,json=talkId,proto3" json:"talk_id,omitempty"`
+	Talker *UserInfo `protobuf:"bytes,2,opt,name=talker,proto3" json:"talker,omitempty"`
+}
+
+func (m *LiveVoiceStartedEvent) Reset()         { *m = LiveVoiceStartedEvent{} }
+func (m *LiveVoiceStartedEvent) String() string { return proto.CompactTextString(m) }
+func (*LiveVoiceStartedEvent) ProtoMessage()    {}
+func (*LiveVoiceStartedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{63}
+}
+func (m *LiveVoiceStartedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiveVoiceStartedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiveVoiceStartedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiveVoiceStartedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiveVoiceStartedEvent.Merge(m, src)
+}
+func (m *LiveVoiceStartedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiveVoiceStartedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiveVoiceStartedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiveVoiceStartedEvent proto.InternalMessageInfo
+
+func (m *LiveVoiceStartedEvent) GetTalkId() string {
+	if m != nil {
+		return m.TalkId
+	}
+	return ""
+}
+
+func (m *LiveVoiceStartedEvent) GetTalker() *UserInfo {
+	if m != nil {
+		return m.Talker
+	}
+	return nil
+}
+
+// LiveVoiceEndedEvent is sent once a peer has stopped talking and the last of their audio has been played.
+type LiveVoiceEndedEvent struct {
+	TalkId string    `protobuf:"bytes,1,opt,name=talk_id,json=talkId,proto3" json:"talk_id,omitempty"`
+	Talker *UserInfo `protobuf:"bytes,2,opt,name=talker,proto3" json:"talker,omitempty"`
+}
+
+func (m *LiveVoiceEndedEvent) Reset()         { *m = LiveVoiceEndedEvent{} }
+func (m *LiveVoiceEndedEvent) String() string { return proto.CompactTextString(m) }
+func (*LiveVoiceEndedEvent) ProtoMessage()    {}
+func (*LiveVoiceEndedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e51414f019018a84, []int{64}
+}
+func (m *LiveVoiceEndedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiveVoiceEndedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiveVoiceEndedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiveVoiceEndedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiveVoiceEndedEvent.Merge(m, src)
+}
+func (m *LiveVoiceEndedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiveVoiceEndedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiveVoiceEndedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiveVoiceEndedEvent proto.InternalMessageInfo
+
+func (m *LiveVoiceEndedEvent) GetTalkId() string {
+	if m != nil {
+		return m.TalkId
+	}
+	return ""
+}
+
+func (m *LiveVoiceEndedEvent) GetTalker() *UserInfo {
+	if m != nil {
+		return m.Talker
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("types.ReceiptType", ReceiptType_name, ReceiptType_value)
+	proto.RegisterEnum("types.ConnectionState", ConnectionState_name, ConnectionState_value)
+	proto.RegisterEnum("types.OutboxStatus", OutboxStatus_name, OutboxStatus_value)
+	proto.RegisterType((*UserInfo)(nil), "types.UserInfo")
+	proto.RegisterType((*Hello)(nil), "types.Hello")
+	proto.RegisterType((*Goodbye)(nil), "types.Goodbye")
+	proto.RegisterType((*Attachment)(nil), "types.Attachment")
+	proto.RegisterType((*AudioAttachment)(nil), "types.AudioAttachment")
+	proto.RegisterType((*Message)(nil), "types.Message")
+	proto.RegisterType((*SignedMessageContent)(nil), "types.SignedMessageContent")
+	proto.RegisterType((*SignedAttachment)(nil), "types.SignedAttachment")
+	proto.RegisterType((*Receipt)(nil), "types.Receipt")
+	proto.RegisterType((*OutboxQueue)(nil), "types.OutboxQueue")
+	proto.RegisterType((*OutboxEntry)(nil), "types.OutboxEntry")
+	proto.RegisterType((*HistoryRequest)(nil), "types.HistoryRequest")
+	proto.RegisterType((*Envelope)(nil), "types.Envelope")
+	proto.RegisterType((*InputDeviceInfo)(nil), "types.InputDeviceInfo")
+	proto.RegisterType((*InputDeviceList)(nil), "types.InputDeviceList")
+	proto.RegisterType((*SelectInputDeviceRequest)(nil), "types.SelectInputDeviceRequest")
+	proto.RegisterType((*OutputDeviceInfo)(nil), "types.OutputDeviceInfo")
+	proto.RegisterType((*OutputDeviceList)(nil), "types.OutputDeviceList")
+	proto.RegisterType((*SelectOutputDeviceRequest)(nil), "types.SelectOutputDeviceRequest")
+	proto.RegisterType((*PlaybackSettings)(nil), "types.PlaybackSettings")
+	proto.RegisterType((*SetMasterGainRequest)(nil), "types.SetMasterGainRequest")
+	proto.RegisterType((*SetMutedRequest)(nil), "types.SetMutedRequest")
+	proto.RegisterType((*SetPeerGainRequest)(nil), "types.SetPeerGainRequest")
+	proto.RegisterType((*BeginAudioRecordingRequest)(nil), "types.BeginAudioRecordingRequest")
+	proto.RegisterType((*BeginTalkRequest)(nil), "types.BeginTalkRequest")
+	proto.RegisterType((*StopAudioRecordingRequest)(nil), "types.StopAudioRecordingRequest")
+	proto.RegisterType((*PlayAudioRecordingRequest)(nil), "types.PlayAudioRecordingRequest")
+	proto.RegisterType((*PlaybackControlRequest)(nil), "types.PlaybackControlRequest")
+	proto.RegisterType((*SeekPlaybackRequest)(nil), "types.SeekPlaybackRequest")
+	proto.RegisterType((*ConnectToPeerRequest)(nil), "types.ConnectToPeerRequest")
+	proto.RegisterType((*JoinRoomRequest)(nil), "types.JoinRoomRequest")
+	proto.RegisterType((*StoredMessage)(nil), "types.StoredMessage")
+	proto.RegisterType((*MessageHistoryRequest)(nil), "types.MessageHistoryRequest")
+	proto.RegisterType((*MessageHistoryPage)(nil), "types.MessageHistoryPage")
+	proto.RegisterType((*MarkMessageReadRequest)(nil), "types.MarkMessageReadRequest")
+	proto.RegisterType((*ApiResponse)(nil), "types.ApiResponse")
+	proto.RegisterType((*ErrorResponse)(nil), "types.ErrorResponse")
+	proto.RegisterType((*OkResponse)(nil), "types.OkResponse")
+	proto.RegisterType((*BeginAudioRecordingResponse)(nil), "types.BeginAudioRecordingResponse")
+	proto.RegisterType((*ImportAudioResponse)(nil), "types.ImportAudioResponse")
+	proto.RegisterType((*Event)(nil), "types.Event")
+	proto.RegisterType((*UserJoinedEvent)(nil), "types.UserJoinedEvent")
+	proto.RegisterType((*UserLeftEvent)(nil), "types.UserLeftEvent")
+	proto.RegisterType((*MessageReceivedEvent)(nil), "types.MessageReceivedEvent")
+	proto.RegisterType((*MessageSentEvent)(nil), "types.MessageSentEvent")
+	proto.RegisterType((*ConnectToPeerRequestedEvent)(nil), "types.ConnectToPeerRequestedEvent")
+	proto.RegisterType((*RelayAddressAcquiredEvent)(nil), "types.RelayAddressAcquiredEvent")
+	proto.RegisterType((*NATTypeDetectedEvent)(nil), "types.NATTypeDetectedEvent")
+	proto.RegisterType((*PeerDiscoveredEvent)(nil), "types.PeerDiscoveredEvent")
+	proto.RegisterType((*JoinRoomRequestedEvent)(nil), "types.JoinRoomRequestedEvent")
+	proto.RegisterType((*RoomMemberFoundEvent)(nil), "types.RoomMemberFoundEvent")
+	proto.RegisterType((*ConnectionStateChangedEvent)(nil), "types.ConnectionStateChangedEvent")
+	proto.RegisterType((*AuthenticationFailedEvent)(nil), "types.AuthenticationFailedEvent")
+	proto.RegisterType((*ReceiptReceivedEvent)(nil), "types.ReceiptReceivedEvent")
+	proto.RegisterType((*MessageReadRequestedEvent)(nil), "types.MessageReadRequestedEvent")
+	proto.RegisterType((*OutboxStatusChangedEvent)(nil), "types.OutboxStatusChangedEvent")
+	proto.RegisterType((*RecordingStoppedEvent)(nil), "types.RecordingStoppedEvent")
+	proto.RegisterType((*PlaybackStartedEvent)(nil), "types.PlaybackStartedEvent")
+	proto.RegisterType((*PlaybackProgressEvent)(nil), "types.PlaybackProgressEvent")
+	proto.RegisterType((*PlaybackFinishedEvent)(nil), "types.PlaybackFinishedEvent")
+	proto.RegisterType((*VoiceFrame)(nil), "types.VoiceFrame")
+	proto.RegisterType((*LiveStreamStats)(nil), "types.LiveStreamStats")
+	proto.RegisterType((*LiveStreamStatsList)(nil), "types.LiveStreamStatsList")
+	proto.RegisterType((*LiveVoiceStartedEvent)(nil), "types.LiveVoiceStartedEvent")
+	proto.RegisterType((*LiveVoiceEndedEvent)(nil), "types.LiveVoiceEndedEvent")
+}
+
+func init() { proto.RegisterFile("partyline.proto", fileDescriptor_e51414f019018a84) }
+
+var fileDescriptor_e51414f019018a84 = []byte{
+	// 2884 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x73, 0x1b, 0xc7,
+	0xb1, 0xe7, 0x02, 0x04, 0x09, 0x34, 0x48, 0x00, 0x1c, 0x7e, 0x68, 0x29, 0xd9, 0x32, 0xbd, 0xef,
+	0x3d, 0x4b, 0xe6, 0xb3, 0x55, 0x36, 0xfd, 0x6c, 0x3f, 0x3b, 0xa9, 0xd8, 0x14, 0x09, 0x89, 0xb0,
+	0x49, 0x91, 0x5e, 0x50, 0xb6, 0x5c, 0x4e, 0xb2, 0x5e, 0x62, 0x87, 0xe4, 0x88, 0xc0, 0xce, 0x6a,
+	0x67, 0x40, 0x93, 0x4e, 0xe5, 0x92, 0xdc, 0xf2, 0x51, 0x49, 0x0e, 0x49, 0x55, 0x2e, 0xa9, 0xca,
+	0x21, 0xf9, 0x5b, 0x92, 0x9b, 0x8f, 0x39, 0xa6, 0xec, 0x43, 0xfe, 0x8d, 0xd4, 0x7c, 0xed, 0x62,
+	0x97, 0x4b, 0x06, 0x72, 0x54, 0x95, 0xdb, 0xce, 0xaf, 0x7b, 0x7a, 0xba, 0x7b, 0x7a, 0xba, 0x7b,
+	0x66, 0xa1, 0x19, 0xf9, 0x31, 0x3f, 0xef, 0x93, 0x10, 0xdf, 0x89, 0x62, 0xca, 0x29, 0xaa, 0xf0,
+	0xf3, 0x08, 0x33, 0xe7, 0x3d, 0xa8, 0x3e, 0x64, 0x38, 0xee, 0x84, 0x87, 0x14, 0x5d, 0x83, 0xe9,
+	0x08, 0xe3, 0xd8, 0x23, 0x81, 0x6d, 0xad, 0x58, 0xb7, 0x6b, 0xee, 0x94, 0x18, 0x76, 0x02, 0x74,
+	0x1d, 0xaa, 0x21, 0xe9, 0x9d, 0x84, 0xfe, 0x00, 0xdb, 0x25, 0x49, 0x49, 0xc6, 0xce, 0x2b, 0x50,
+	0xd9, 0xc2, 0xfd, 0x3e, 0x45, 0xff, 0x05, 0x93, 0x43, 0x86, 0x63, 0x39, 0xb5, 0xbe, 0xd6, 0xbc,
+	0x23, 0xe5, 0xdf, 0x31, 0xc2, 0x5d, 0x49, 0x74, 0xee, 0xc0, 0xf4, 0x7d, 0x4a, 0x83, 0x83, 0x73,
+	0x3c, 0x1e, 0xff, 0x3e, 0xc0, 0x3a, 0xe7, 0x7e, 0xef, 0x78, 0x80, 0x43, 0x8e, 0x1a, 0x50, 0x4a,
+	0x74, 0x2b, 0x91, 0x00, 0xdd, 0x81, 0x8a, 0x3f, 0x0c, 0x08, 0xb5, 0xb1, 0x94, 0xb1, 0xa4, 0x65,
+	0xac, 0x0b, 0x2c, 0x9d, 0xb6, 0x35, 0xe1, 0x2a, 0xb6, 0xbb, 0x53, 0x30, 0x79, 0x42, 0xc2, 0xc0,
+	0xf9, 0x99, 0x05, 0xcd, 0x1c, 0x13, 0x5a, 0x80, 0x4a, 0x8f, 0x06, 0xb8, 0xa7, 0xc5, 0xab, 0x01,
+	0x72, 0x60, 0xf6, 0x30, 0xf6, 0x07, 0xd8, 0x63, 0xe4, 0x4b, 0xec, 0x0d, 0x98, 0x34, 0xbf, 0xe2,
+	0xd6, 0x25, 0xd8, 0x25, 0x5f, 0xe2, 0x1d, 0x86, 0x96, 0x60, 0x4a, 0x0e, 0x99, 0x5d, 0x5e, 0x29,
+	0xdf, 0x9e, 0x71, 0xf5, 0x08, 0xfd, 0x0f, 0x34, 0x7a, 0x34, 0xe4, 0x38, 0xe4, 0x1e, 0x3b, 0xf6,
+	0xd7, 0xde, 0x7c, 0xcb, 0x9e, 0x5c, 0xb1, 0x6e, 0xcf, 0xb8, 0xb3, 0x1a, 0xed, 0x4a, 0xd0, 0xf9,
+	0x43, 0x09, 0xa6, 0x77, 0x30, 0x63, 0xfe, 0x11, 0x46, 0xb7, 0x60, 0xca, 0x1f, 0xf2, 0x63, 0x7a,
+	0xa9, 0x57, 0x34, 0x19, 0xbd, 0x0c, 0x73, 0x4c, 0x08, 0xf6, 0xb9, 0xc7, 0xc9, 0x00, 0x7b, 0xc3,
+	0x90, 0x9c, 0x49, 0xdd, 0xca, 0x6e, 0x43, 0x10, 0xd6, 0xf9, 0x3e, 0x19, 0xe0, 0x87, 0x21, 0x39,
+	0x43, 0x2f, 0xc2, 0x0c, 0xc7, 0x67, 0xdc, 0xd3, 0xab, 0xda, 0x65, 0x69, 0x5f, 0x5d, 0x60, 0x1b,
+	0x0a, 0x42, 0x6f, 0x40, 0xdd, 0x4f, 0x3c, 0xc1, 0xec, 0xc9, 0x95, 0xf2, 0xed, 0xfa, 0xda, 0x9c,
+	0xf1, 0x66, 0x42, 0x71, 0x47, 0xb9, 0xd0, 0x73, 0x50, 0x63, 0xe4, 0x28, 0xf4, 0xf9, 0x30, 0xc6,
+	0x76, 0x45, 0x5a, 0x96, 0x02, 0x68, 0x15, 0xe6, 0xc4, 0x00, 0xc7, 0x5e, 0x34, 0x3c, 0xe8, 0x93,
+	0x9e, 0x77, 0x82, 0xcf, 0xed, 0x29, 0xc9, 0xd5, 0x54, 0x84, 0x3d, 0x89, 0x7f, 0x88, 0xcf, 0xd1,
+	0xf3, 0x00, 0x03, 0xe5, 0x00, 0x11, 0x7a, 0xd3, 0x52, 0xbf, 0x9a, 0x46, 0x3a, 0x81, 0xf3, 0x0f,
+	0x0b, 0x16, 0xba, 0x62, 0x4a, 0xa0, 0xdd, 0x64, 0xd4, 0xce, 0xce, 0xb3, 0x72, 0xf3, 0x46, 0x9c,
+	0x59, 0xfa, 0x16, 0xce, 0x2c, 0x8f, 0xe5, 0xcc, 0xc9, 0x8b, 0xce, 0x7c, 0x27, 0xeb, 0xcc, 0x8a,
+	0x74, 0xe6, 0x35, 0xbd, 0xb6, 0xb2, 0xe3, 0x12, 0x97, 0x3a, 0x1e, 0xb4, 0xf2, 0x0c, 0x17, 0x62,
+	0x3e, 0x89, 0xd3, 0xd2, 0x68, 0x9c, 0x5e, 0x8c, 0xb5, 0x72, 0x51, 0xac, 0xfd, 0xd9, 0x82, 0x69,
+	0x17, 0xf7, 0x30, 0x89, 0xfe, 0xa5, 0xf7, 0x5e, 0x85, 0x5a, 0x8c, 0x7b, 0x24, 0x22, 0xc2, 0xcc,
+	0x4b, 0x1c, 0x98, 0x72, 0xa0, 0x97, 0x60, 0x52, 0x10, 0xe5, 0xb2, 0x8d, 0x35, 0xa4, 0x39, 0xf5,
+	0x5a, 0xfb, 0xe7, 0x11, 0x76, 0x25, 0x5d, 0x28, 0x2a, 0x7c, 0xcc, 0xb8, 0x3f, 0x88, 0x94, 0xa3,
+	0x27, 0xa5, 0xa3, 0x67, 0x13, 0x54, 0xf8, 0xd9, 0xf9, 0x1c, 0xea, 0xbb, 0x43, 0x7e, 0x40, 0xcf,
+	0x3e, 0x1a, 0xe2, 0xe1, 0x78, 0xb9, 0x02, 0xbd, 0x02, 0xd3, 0x38, 0xe4, 0x31, 0xc1, 0xe2, 0x94,
+	0x0a, 0xa7, 0x1b, 0x2d, 0x94, 0xa4, 0x76, 0xc8, 0xe3, 0x73, 0xd7, 0xb0, 0x38, 0x3f, 0x80, 0xfa,
+	0x08, 0x8e, 0x6e, 0xc3, 0xb4, 0xb6, 0x5d, 0x2f, 0xd2, 0xd0, 0x93, 0x75, 0xcc, 0xb9, 0x86, 0x8c,
+	0xfe, 0x1b, 0x1a, 0x4f, 0x84, 0x52, 0x81, 0x88, 0x97, 0x91, 0x73, 0x37, 0xa3, 0xd0, 0x75, 0x2e,
+	0x0d, 0xf8, 0x0c, 0x1a, 0x5b, 0x84, 0x71, 0x1a, 0x9f, 0xbb, 0xf8, 0xc9, 0x10, 0x33, 0xe9, 0x6f,
+	0x46, 0xc2, 0x9e, 0x0e, 0x2f, 0x4b, 0xce, 0xa9, 0x49, 0x44, 0x46, 0xd6, 0x2a, 0xcc, 0x9d, 0x84,
+	0xf4, 0x8b, 0xd0, 0x4b, 0x37, 0x45, 0xd9, 0x51, 0x73, 0x9b, 0x92, 0xb0, 0x63, 0xb6, 0x86, 0x39,
+	0xbf, 0xb7, 0xa0, 0xda, 0x0e, 0x4f, 0x71, 0x9f, 0x46, 0xe2, 0xa4, 0x5d, 0xad, 0xf9, 0xd6, 0x44,
+	0xaa, 0xfb, 0x2a, 0x4c, 0x1f, 0xa9, 0xf4, 0x6b, 0x97, 0x32, 0xbc, 0x3a, 0x29, 0x0b, 0x5e, 0xcd,
+	0x20, 0x78, 0x63, 0xb5, 0x7d, 0x76, 0x39, 0xc3, 0xab, 0x37, 0x55, 0xf0, 0x6a, 0x86, 0xbb, 0x35,
+	0x98, 0x8e, 0xfc, 0xf3, 0x3e, 0xf5, 0x03, 0xe7, 0x27, 0x16, 0x34, 0x3b, 0x61, 0x34, 0xe4, 0x9b,
+	0xf8, 0x94, 0xf4, 0xb0, 0x2c, 0x2c, 0x37, 0xa0, 0x16, 0xc8, 0x51, 0x1a, 0x69, 0x55, 0x05, 0x74,
+	0x02, 0x84, 0x60, 0x72, 0xa4, 0xb0, 0xc8, 0x6f, 0xe1, 0x2b, 0xc2, 0xbc, 0x00, 0x1f, 0xfa, 0xc3,
+	0xbe, 0x5a, 0xbe, 0xea, 0xd6, 0x08, 0xdb, 0x54, 0x00, 0x7a, 0x01, 0xea, 0x84, 0x79, 0x0c, 0xf7,
+	0x71, 0x8f, 0xe3, 0x40, 0x46, 0x50, 0xd5, 0x05, 0xc2, 0xba, 0x1a, 0x71, 0x36, 0x32, 0x3a, 0x6c,
+	0x13, 0xc6, 0xd1, 0x6b, 0x30, 0xad, 0x96, 0x64, 0xb6, 0xb5, 0x52, 0x1e, 0xa9, 0x16, 0x39, 0x65,
+	0x5d, 0xc3, 0xe6, 0xbc, 0x0d, 0xb6, 0x12, 0x38, 0xc2, 0x61, 0x36, 0xf3, 0x2a, 0x8b, 0x9c, 0x9f,
+	0x5a, 0xd0, 0xda, 0x1d, 0xf2, 0xff, 0xb0, 0x0f, 0xda, 0x59, 0x25, 0xa4, 0x13, 0x5e, 0xcf, 0x3b,
+	0xe1, 0x5a, 0x7a, 0x44, 0x2e, 0xf1, 0xc2, 0xff, 0xc3, 0xb2, 0x12, 0x39, 0xca, 0x32, 0x96, 0x1b,
+	0x3a, 0xd0, 0xda, 0xeb, 0xfb, 0xe7, 0x07, 0x7e, 0xef, 0xa4, 0x8b, 0x39, 0x27, 0xe1, 0x11, 0x13,
+	0x5a, 0x0f, 0x7c, 0xc6, 0x71, 0xec, 0x1d, 0xf9, 0x24, 0x94, 0x53, 0x2c, 0x17, 0x14, 0x74, 0xdf,
+	0x27, 0xa1, 0x48, 0x6f, 0x83, 0xa1, 0x30, 0xa8, 0x24, 0x0d, 0x52, 0x03, 0x67, 0x15, 0x16, 0xba,
+	0x98, 0xef, 0x24, 0x6c, 0x66, 0x7d, 0x04, 0x93, 0x23, 0x72, 0xe4, 0xb7, 0x73, 0x0b, 0x9a, 0x82,
+	0x57, 0xcc, 0x33, 0x6c, 0x89, 0x50, 0x6b, 0x54, 0xe8, 0x3a, 0xa0, 0x2e, 0xe6, 0x7b, 0x38, 0x2b,
+	0xf2, 0xd2, 0x26, 0xc8, 0xac, 0x55, 0x1a, 0x59, 0xeb, 0x3d, 0xb8, 0x7e, 0x17, 0x1f, 0x91, 0x50,
+	0x36, 0x13, 0x2e, 0xee, 0xd1, 0x38, 0x20, 0xe1, 0x91, 0x11, 0xf5, 0x22, 0xcc, 0x0c, 0xfc, 0x33,
+	0x2f, 0x18, 0xc6, 0x3e, 0x27, 0x34, 0xd4, 0xf2, 0xea, 0x03, 0xff, 0x6c, 0x53, 0x43, 0xce, 0xf7,
+	0xa1, 0x25, 0x05, 0xec, 0xfb, 0xfd, 0x93, 0xf1, 0xa7, 0x89, 0x2c, 0xca, 0xfc, 0x53, 0xec, 0xc5,
+	0x66, 0x49, 0xed, 0xae, 0x59, 0x81, 0x26, 0x7a, 0x38, 0xdf, 0x83, 0xe5, 0x2e, 0xa7, 0xd1, 0xa5,
+	0xda, 0x25, 0xd3, 0x53, 0x6b, 0xeb, 0x09, 0xd6, 0x09, 0x9c, 0x08, 0x96, 0xc5, 0x0e, 0x7e, 0xdb,
+	0xf9, 0x45, 0x2e, 0x13, 0x6d, 0x03, 0x09, 0x39, 0x8e, 0xe3, 0x61, 0x94, 0x46, 0xb5, 0x01, 0x9c,
+	0xef, 0xc0, 0x92, 0x89, 0x19, 0x51, 0x4f, 0x63, 0xda, 0x7f, 0x0a, 0x75, 0x3f, 0x85, 0xf9, 0x2e,
+	0xc6, 0x27, 0x46, 0xc0, 0x53, 0x28, 0xfa, 0x02, 0xa8, 0x8e, 0xce, 0x23, 0x61, 0x80, 0x55, 0x42,
+	0x9f, 0x75, 0x41, 0x42, 0x1d, 0x81, 0x38, 0xef, 0xc0, 0xc2, 0x06, 0x0d, 0x43, 0xdc, 0xe3, 0xfb,
+	0x54, 0x44, 0xcc, 0x88, 0x6c, 0x19, 0x2d, 0x7d, 0xda, 0xf3, 0xb9, 0x6e, 0xdb, 0x6a, 0x6e, 0x5d,
+	0x60, 0xdb, 0x0a, 0x72, 0xee, 0x40, 0xf3, 0x03, 0x4a, 0x42, 0x97, 0xd2, 0xc1, 0xc8, 0xb1, 0x89,
+	0x29, 0x1d, 0x78, 0xf2, 0xcc, 0xeb, 0x63, 0x23, 0x80, 0x07, 0xa2, 0xa1, 0xfe, 0x95, 0x05, 0xb3,
+	0x5d, 0x4e, 0xe3, 0xa4, 0xdd, 0x79, 0x8a, 0xda, 0x74, 0x1d, 0xaa, 0xa7, 0x38, 0x26, 0x87, 0x24,
+	0x39, 0x40, 0xc9, 0x58, 0x6c, 0x06, 0x33, 0xfd, 0x5f, 0xd5, 0x95, 0xdf, 0xa2, 0x96, 0x31, 0xb9,
+	0x54, 0x52, 0xcb, 0x54, 0x35, 0x9e, 0x51, 0xa8, 0xae, 0x65, 0xbf, 0xb0, 0x60, 0xd1, 0x14, 0x93,
+	0x6c, 0x4d, 0x5b, 0x85, 0xb9, 0x03, 0x7c, 0x48, 0x63, 0xec, 0x5d, 0x68, 0x25, 0x9a, 0x8a, 0x90,
+	0x54, 0x2d, 0x71, 0x08, 0xfb, 0x64, 0x40, 0xb8, 0x6e, 0xa1, 0xd5, 0x00, 0xbd, 0x0e, 0x8b, 0x5a,
+	0x82, 0x6a, 0xc6, 0x3c, 0x73, 0xf8, 0x54, 0x9b, 0x8a, 0x14, 0x71, 0x5d, 0xd2, 0xf6, 0xe4, 0x41,
+	0x74, 0x7c, 0x40, 0x59, 0x6d, 0xf6, 0x84, 0xe9, 0xaf, 0x41, 0x55, 0xeb, 0x60, 0x72, 0xdb, 0x82,
+	0xe9, 0xb9, 0x46, 0x9d, 0xe9, 0x26, 0x5c, 0x68, 0x19, 0xaa, 0xc7, 0x3e, 0xf3, 0x06, 0x34, 0xc6,
+	0xda, 0x59, 0xd3, 0xc7, 0x3e, 0xdb, 0xa1, 0x31, 0x76, 0x3e, 0x87, 0xa5, 0x1d, 0x3f, 0x3e, 0x31,
+	0x73, 0xb0, 0x1f, 0x8c, 0x54, 0xf1, 0x67, 0xd1, 0x73, 0x3a, 0x7f, 0x2d, 0x41, 0x7d, 0x3d, 0x22,
+	0x2e, 0x66, 0x11, 0x0d, 0x99, 0xe8, 0x70, 0x4a, 0xf4, 0x44, 0x6f, 0xaf, 0xe9, 0xbc, 0x77, 0x4f,
+	0x0c, 0x79, 0x6b, 0xc2, 0x2d, 0xd1, 0x13, 0xf4, 0x0a, 0x54, 0x70, 0x1c, 0x27, 0xc2, 0x8d, 0x81,
+	0x6d, 0x81, 0x8d, 0xb0, 0x2a, 0x26, 0xf4, 0x48, 0xb8, 0xf6, 0x88, 0x84, 0x9e, 0xbc, 0xfc, 0x8c,
+	0xe4, 0x0a, 0x55, 0xce, 0x1d, 0x3d, 0xbb, 0x30, 0x81, 0x25, 0xb2, 0xe6, 0x0f, 0x2e, 0x92, 0xd1,
+	0x26, 0x34, 0x8d, 0x13, 0x8e, 0xd5, 0x16, 0xc8, 0xb8, 0xa9, 0xaf, 0x2d, 0xe7, 0x5a, 0x8f, 0x74,
+	0x7f, 0xb6, 0x26, 0xdc, 0xc6, 0x20, 0x83, 0xa2, 0xf7, 0x60, 0x86, 0x0c, 0x22, 0x1a, 0x73, 0xa5,
+	0xa0, 0xbc, 0x43, 0xd4, 0xd7, 0xae, 0x9b, 0xb2, 0x2c, 0x49, 0x7a, 0xe1, 0x44, 0x9d, 0x3a, 0x49,
+	0x61, 0x71, 0x9d, 0x8b, 0x31, 0x8b, 0x9c, 0x97, 0x61, 0x36, 0xe3, 0x02, 0x64, 0x8b, 0x32, 0xc7,
+	0x7d, 0xd2, 0x67, 0x7a, 0x87, 0xcc, 0xd0, 0x99, 0x01, 0x48, 0xbd, 0xea, 0xbc, 0x0f, 0x37, 0xae,
+	0xb0, 0x7e, 0xcc, 0x94, 0x53, 0xa0, 0xe8, 0x98, 0x29, 0xc7, 0x64, 0x78, 0x73, 0xaf, 0x2c, 0xbb,
+	0x60, 0xa0, 0x1d, 0xe6, 0xfc, 0x66, 0x16, 0x2a, 0xed, 0x53, 0x71, 0x4a, 0x2f, 0xf6, 0xcc, 0x56,
+	0x41, 0xcf, 0x2c, 0x2e, 0x1e, 0xa2, 0x0f, 0xf6, 0x1e, 0x53, 0x12, 0xe2, 0x20, 0x77, 0x27, 0x16,
+	0x01, 0xf8, 0x81, 0x24, 0x48, 0x99, 0x5b, 0x13, 0x2e, 0x0c, 0x13, 0x08, 0xbd, 0x01, 0x35, 0x39,
+	0xb5, 0x8f, 0x0f, 0xb9, 0x7d, 0x98, 0x09, 0x2e, 0x31, 0x71, 0x1b, 0x1f, 0x72, 0x33, 0xad, 0x3a,
+	0xd4, 0x00, 0xda, 0x82, 0x96, 0x89, 0x02, 0xd9, 0x07, 0x9e, 0xe2, 0xc0, 0x3e, 0x92, 0x73, 0x6f,
+	0xe4, 0xf2, 0x93, 0xa6, 0x1a, 0x11, 0xcd, 0x41, 0x16, 0x47, 0xdf, 0x85, 0x19, 0x23, 0x49, 0xa6,
+	0xa8, 0xe3, 0x15, 0x6b, 0xa4, 0x37, 0xd1, 0x52, 0xba, 0x38, 0x4c, 0x94, 0xa8, 0x0f, 0x52, 0x0c,
+	0x79, 0xb0, 0xdc, 0x53, 0xb9, 0xd9, 0xe3, 0x54, 0xe5, 0x8f, 0x58, 0x9d, 0x56, 0x1c, 0xd8, 0x24,
+	0x13, 0xeb, 0x45, 0x39, 0x3c, 0xd5, 0x6b, 0xa9, 0x57, 0x48, 0x46, 0x8f, 0x60, 0x29, 0xc6, 0x7d,
+	0xff, 0xdc, 0xf3, 0x83, 0x20, 0xc6, 0x8c, 0x79, 0x7e, 0xef, 0xc9, 0x90, 0xc4, 0x38, 0xb0, 0x1f,
+	0x4b, 0xe9, 0x2b, 0x49, 0x63, 0x2c, 0x8a, 0xa5, 0xe2, 0x59, 0xd7, 0x2c, 0x46, 0xf6, 0x42, 0x5c,
+	0x40, 0x44, 0x1d, 0x98, 0x0b, 0xc5, 0xad, 0xf3, 0x3c, 0xc2, 0x5e, 0x80, 0xb9, 0x6a, 0xe5, 0x4e,
+	0x32, 0x3e, 0x7c, 0xb0, 0xbe, 0x2f, 0xae, 0x4f, 0x9b, 0x9a, 0x9a, 0xf8, 0x30, 0xf4, 0xf9, 0x28,
+	0x8e, 0xda, 0xd0, 0x94, 0xa6, 0x07, 0x84, 0xf5, 0xe8, 0x29, 0x16, 0xda, 0xf5, 0x33, 0x07, 0x4a,
+	0xd8, 0xb4, 0x99, 0x10, 0x8d, 0x9c, 0x46, 0x94, 0x81, 0xd1, 0x2e, 0xcc, 0x8b, 0xf8, 0xf1, 0x64,
+	0x7d, 0x4a, 0xdd, 0x38, 0x90, 0xa2, 0x9e, 0xd7, 0xa2, 0x72, 0xf5, 0x2c, 0x95, 0x36, 0xf7, 0x38,
+	0x4f, 0x11, 0x26, 0x4a, 0x59, 0x03, 0x3c, 0x38, 0xc0, 0xb1, 0x77, 0x48, 0x87, 0x61, 0x60, 0x87,
+	0x19, 0x13, 0xc5, 0x84, 0x1d, 0x49, 0xbe, 0x27, 0xa8, 0x89, 0x89, 0x71, 0x16, 0x47, 0x3f, 0x04,
+	0x5b, 0xef, 0x90, 0x38, 0x34, 0x8c, 0xfb, 0x1c, 0x7b, 0xbd, 0x63, 0x3f, 0x3c, 0xc2, 0x81, 0x4d,
+	0x8b, 0xf6, 0x99, 0xd0, 0xb0, 0x2b, 0xb8, 0x36, 0x14, 0x53, 0x7e, 0x9f, 0x73, 0x64, 0xf4, 0x09,
+	0x2c, 0x8a, 0xec, 0x8c, 0x43, 0x4e, 0x7a, 0xea, 0x60, 0x1e, 0xfa, 0xa4, 0x8f, 0x03, 0x3b, 0xca,
+	0x6c, 0xf3, 0x7a, 0x86, 0xe7, 0x9e, 0x64, 0x49, 0xb6, 0xd9, 0x2f, 0x20, 0x8a, 0x93, 0xa2, 0x6f,
+	0x4a, 0xe9, 0x49, 0x79, 0x92, 0x75, 0x81, 0x22, 0x5f, 0x38, 0x29, 0x71, 0x16, 0x17, 0xa1, 0x98,
+	0x9e, 0x39, 0x3f, 0x18, 0xd9, 0xa1, 0x38, 0xa3, 0xe3, 0xc5, 0xca, 0x35, 0xa2, 0xe3, 0xa0, 0x80,
+	0x88, 0x1e, 0xc2, 0x22, 0x95, 0xf7, 0x61, 0xe9, 0xd8, 0x21, 0x4b, 0x3c, 0xcb, 0xa4, 0xe0, 0x17,
+	0x32, 0x77, 0xe9, 0xae, 0x64, 0xc9, 0xb9, 0x75, 0x9e, 0x5e, 0xa4, 0xa1, 0x0f, 0x61, 0x2e, 0xcd,
+	0x84, 0x8c, 0xd3, 0x28, 0xc2, 0x81, 0xcd, 0xa5, 0xc8, 0xe7, 0x52, 0xdb, 0x15, 0xbd, 0xab, 0xc8,
+	0x46, 0x5e, 0x2b, 0xce, 0x11, 0x84, 0x1f, 0x23, 0xdd, 0xdc, 0x09, 0x2d, 0x63, 0x61, 0xf7, 0x30,
+	0xe3, 0xc7, 0xe4, 0xc2, 0xa1, 0xa8, 0x89, 0x1f, 0xa3, 0x2c, 0x2e, 0xd4, 0x4a, 0x24, 0x45, 0x31,
+	0x3d, 0x12, 0xa7, 0xd2, 0x3e, 0xcd, 0xa8, 0x65, 0x44, 0xed, 0x69, 0x72, 0xa2, 0x56, 0x94, 0x23,
+	0x64, 0x84, 0x1d, 0x92, 0x90, 0xb0, 0x63, 0x1c, 0xd8, 0x5f, 0x14, 0x0a, 0xbb, 0xa7, 0xc9, 0x17,
+	0x84, 0x19, 0x02, 0xda, 0x06, 0xd4, 0x27, 0xa7, 0xd8, 0x3b, 0xa5, 0xe2, 0x5a, 0x65, 0xac, 0x3c,
+	0xcb, 0x48, 0xdb, 0x26, 0xa7, 0xf8, 0x63, 0x41, 0xcf, 0x99, 0xd9, 0xea, 0xe7, 0x08, 0xe8, 0x1e,
+	0xb4, 0x46, 0xa4, 0xe1, 0x30, 0xc0, 0x81, 0x7d, 0x9e, 0x49, 0x0b, 0x89, 0xac, 0x76, 0x18, 0xa4,
+	0x92, 0x1a, 0xfd, 0x0c, 0x7c, 0xb7, 0x02, 0x65, 0x7c, 0xca, 0x9d, 0xb7, 0xa0, 0x99, 0x2b, 0x24,
+	0xe3, 0x3d, 0xe3, 0xfe, 0x1f, 0xcc, 0x66, 0xea, 0xc8, 0x78, 0xb3, 0x7e, 0x04, 0x0b, 0x45, 0x15,
+	0xe4, 0x19, 0xf5, 0xc3, 0xd9, 0x4e, 0xae, 0x9c, 0x7f, 0x75, 0xfc, 0x0c, 0x5a, 0xf9, 0xc2, 0xf3,
+	0x14, 0x0b, 0x67, 0x85, 0x97, 0xf2, 0xc2, 0xf7, 0xe1, 0xc6, 0x15, 0xa5, 0x08, 0xbd, 0x29, 0x9e,
+	0x5e, 0x24, 0x62, 0x5b, 0x99, 0xf0, 0x2e, 0x9a, 0xe4, 0x1a, 0x5e, 0xe7, 0x5d, 0x58, 0xbe, 0xb4,
+	0x04, 0x09, 0x8d, 0xd2, 0x22, 0x66, 0x1a, 0xd7, 0xa4, 0x28, 0x39, 0x7f, 0xb2, 0x60, 0xa1, 0xa8,
+	0xd4, 0xa0, 0x57, 0x01, 0xf1, 0xd8, 0x0f, 0x99, 0x6c, 0xd4, 0xe4, 0xaf, 0x83, 0x1e, 0xed, 0xeb,
+	0xf9, 0x73, 0x09, 0x65, 0x4f, 0x13, 0xd0, 0x4b, 0x20, 0x2a, 0x93, 0xa7, 0x5f, 0x05, 0xe4, 0x93,
+	0xa0, 0xb2, 0x7e, 0x36, 0xf4, 0xf5, 0xe3, 0x81, 0x58, 0x03, 0xbd, 0x05, 0xd7, 0x8e, 0x69, 0x1f,
+	0x7b, 0xd1, 0x30, 0xec, 0x1d, 0xcb, 0xdc, 0x30, 0x8c, 0x84, 0x20, 0x1c, 0xe8, 0x0b, 0xca, 0xa2,
+	0x20, 0xef, 0x69, 0x6a, 0xd7, 0x10, 0x9d, 0x3d, 0x98, 0x2f, 0x28, 0x64, 0xe3, 0x3d, 0x10, 0x2e,
+	0x40, 0x45, 0x18, 0x6f, 0x9e, 0xd5, 0xd4, 0xc0, 0xf9, 0x00, 0x96, 0x8a, 0xeb, 0x99, 0x78, 0x32,
+	0xca, 0x6e, 0xc3, 0x52, 0x71, 0xfd, 0x4b, 0x77, 0xe0, 0x11, 0x2c, 0x14, 0x15, 0xb3, 0x2b, 0x2f,
+	0x7c, 0x89, 0xee, 0xa5, 0xab, 0xce, 0x42, 0x94, 0x44, 0x4c, 0x51, 0x51, 0x1b, 0xf7, 0x81, 0xb4,
+	0x22, 0x8b, 0xa6, 0x5c, 0xa9, 0x91, 0x58, 0x93, 0x93, 0xeb, 0x2a, 0x26, 0xe7, 0x97, 0x16, 0x2c,
+	0x5f, 0x5a, 0xea, 0xe4, 0xcd, 0x11, 0x87, 0x01, 0x4e, 0x2f, 0x6c, 0xca, 0xac, 0x19, 0x85, 0xaa,
+	0xab, 0x1a, 0x5a, 0x83, 0x99, 0x5e, 0xdf, 0x27, 0x03, 0x1c, 0x78, 0x57, 0x99, 0x58, 0xd7, 0x4c,
+	0x02, 0x10, 0xbf, 0x53, 0x62, 0xec, 0x33, 0x1a, 0xea, 0x33, 0xa9, 0x47, 0xce, 0xfb, 0xb0, 0x50,
+	0x54, 0x25, 0xc5, 0xa1, 0x34, 0xef, 0x94, 0x56, 0xd1, 0x3b, 0x65, 0xf2, 0x4a, 0xe9, 0xec, 0xc3,
+	0xf2, 0xa5, 0x75, 0x11, 0xbd, 0x9d, 0xdf, 0x6c, 0xd3, 0xec, 0x14, 0x5f, 0x04, 0xd3, 0x3d, 0xff,
+	0xad, 0x05, 0xf6, 0x65, 0x55, 0xf1, 0x19, 0x3f, 0xb2, 0xff, 0x2f, 0x4c, 0xa9, 0xe2, 0xac, 0x9f,
+	0xd9, 0xe7, 0x0b, 0x8a, 0xb2, 0xab, 0x59, 0x9c, 0x77, 0x61, 0xb1, 0xb0, 0xb2, 0x8e, 0x73, 0xad,
+	0xf9, 0x31, 0x2c, 0x14, 0x55, 0xd2, 0x31, 0xa6, 0x0a, 0x16, 0x4e, 0xb9, 0xdf, 0xf7, 0xf4, 0x3f,
+	0x31, 0xf5, 0x96, 0x52, 0x97, 0xd8, 0x3d, 0x09, 0xe5, 0xaf, 0x3e, 0xe5, 0x0b, 0x57, 0x9f, 0xdf,
+	0x59, 0xb0, 0x58, 0x58, 0x7e, 0x9f, 0xc5, 0x5b, 0xce, 0x05, 0x0d, 0xcb, 0x17, 0x35, 0x5c, 0x82,
+	0xa9, 0xc8, 0x1f, 0xb2, 0xe4, 0x5d, 0x55, 0x8f, 0x9c, 0xfd, 0x54, 0xaf, 0x4c, 0x25, 0x1f, 0x47,
+	0x2f, 0x1b, 0xa6, 0x4d, 0xff, 0xa3, 0x5f, 0x1b, 0xf4, 0xd0, 0xf9, 0xb9, 0x05, 0x20, 0x6b, 0xad,
+	0x5c, 0x5d, 0xbc, 0x40, 0x72, 0xbf, 0x7f, 0x32, 0xf2, 0x02, 0x29, 0x86, 0x9d, 0x00, 0xb5, 0xa0,
+	0xcc, 0xf0, 0x13, 0x6d, 0x91, 0xf8, 0x14, 0xe1, 0x45, 0xa3, 0x21, 0x53, 0x96, 0xe8, 0x5f, 0x3e,
+	0x35, 0x81, 0x28, 0x49, 0x2d, 0x28, 0xe3, 0xd0, 0xd8, 0x20, 0x3e, 0xa5, 0xed, 0xc9, 0x55, 0x72,
+	0xc0, 0xec, 0x8a, 0xb6, 0xdd, 0x60, 0x3b, 0xcc, 0xf9, 0x63, 0x09, 0x9a, 0xa2, 0x29, 0xe8, 0xf2,
+	0x18, 0xfb, 0x03, 0x11, 0x54, 0xec, 0xf2, 0x47, 0xd1, 0x11, 0x5d, 0x4b, 0x19, 0x5d, 0x97, 0x60,
+	0xca, 0xef, 0x71, 0x72, 0x8a, 0x75, 0x3a, 0xd7, 0x23, 0x51, 0x91, 0x93, 0x16, 0x58, 0xe8, 0x35,
+	0xe9, 0x26, 0x63, 0xf1, 0x42, 0xd5, 0x17, 0xe9, 0xa9, 0x22, 0x71, 0xf9, 0x2d, 0x31, 0xca, 0xb8,
+	0x3d, 0xa5, 0x31, 0xca, 0xb8, 0x78, 0x42, 0x8c, 0xb1, 0x4e, 0xff, 0xf2, 0x77, 0xe1, 0xa4, 0x9b,
+	0x02, 0x82, 0xda, 0xa3, 0x61, 0x0f, 0xfb, 0xa2, 0x73, 0xaf, 0x2a, 0x6a, 0x02, 0x88, 0x4c, 0xfc,
+	0x98, 0x70, 0x8e, 0x63, 0x61, 0x7d, 0x4d, 0xbe, 0x4b, 0x56, 0x15, 0xb0, 0xc3, 0xd0, 0x2d, 0x68,
+	0x1e, 0x0c, 0x0f, 0x0f, 0x85, 0x18, 0x13, 0x1c, 0x20, 0x1d, 0xd4, 0x30, 0xb0, 0x8a, 0x0f, 0xe7,
+	0x3e, 0xcc, 0xe7, 0x5c, 0x64, 0xfe, 0x31, 0x30, 0x09, 0xe5, 0xff, 0x31, 0xe4, 0x98, 0x5d, 0xc3,
+	0xe6, 0x7c, 0x0a, 0x8b, 0x85, 0xcd, 0xdc, 0xe5, 0x41, 0x70, 0x0b, 0xe4, 0xd7, 0xe5, 0xc9, 0x54,
+	0x93, 0x9d, 0x4f, 0x60, 0x3e, 0x11, 0x9d, 0xf6, 0x76, 0xff, 0xbe, 0xe0, 0xd5, 0x97, 0xa0, 0x3e,
+	0xf2, 0x5f, 0x0f, 0xcd, 0x42, 0x6d, 0xb3, 0xbd, 0xdd, 0xf9, 0xb8, 0xed, 0xb6, 0x37, 0x5b, 0x13,
+	0xa8, 0x0a, 0x93, 0x6e, 0x7b, 0x7d, 0xb3, 0x65, 0xad, 0x7e, 0x06, 0xcd, 0x5c, 0x69, 0x41, 0x2d,
+	0x98, 0xd9, 0xec, 0x74, 0x37, 0x76, 0x1f, 0x3c, 0x68, 0x6f, 0xec, 0x4b, 0xf6, 0x06, 0x80, 0x1e,
+	0x76, 0x1e, 0xdc, 0x6f, 0x59, 0x42, 0x5a, 0x4a, 0x2e, 0xa1, 0x3a, 0x4c, 0xbb, 0xed, 0xed, 0xf5,
+	0x4f, 0xdb, 0x9b, 0xad, 0x32, 0x02, 0x98, 0xda, 0xec, 0xb8, 0xed, 0x8d, 0xfd, 0xd6, 0xe4, 0xea,
+	0x87, 0x30, 0x33, 0x9a, 0xf5, 0xd0, 0x1c, 0xcc, 0xee, 0x3e, 0xdc, 0xbf, 0xbb, 0xfb, 0xc8, 0xfb,
+	0xe8, 0x61, 0xfb, 0xa1, 0x14, 0xbd, 0x00, 0x2d, 0x0d, 0xa5, 0xfa, 0x59, 0x08, 0x41, 0x43, 0xa3,
+	0xed, 0x47, 0x7b, 0x1d, 0x81, 0x95, 0xee, 0xda, 0x7f, 0xf9, 0xfa, 0xa6, 0xf5, 0xd5, 0xd7, 0x37,
+	0xad, 0xbf, 0x7f, 0x7d, 0xd3, 0xfa, 0xf5, 0x37, 0x37, 0x27, 0xbe, 0xfa, 0xe6, 0xe6, 0xc4, 0xdf,
+	0xbe, 0xb9, 0x39, 0x71, 0x30, 0x25, 0x3b, 0x9e, 0x37, 0xfe, 0x39, 0x00, 0x22, 0x6b, 0xf0, 0x3b,
+	0x3f, 0x21, 0x00, 0x00,
+}
+
+func (m *UserInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nickname) > 0 {
+		i -= len(m.Nickname)
+		copy(dAtA[i:], m.Nickname)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Nickname)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hello) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hello) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Goodbye) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Goodbye) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Goodbye) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Attachment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attachment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attachment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Kind != nil {
+		{
+			size := m.Kind.Size()
+			i -= size
+			if _, err := m.Kind.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Attachment_Audio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attachment_Audio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Audio != nil {
+		{
+			size, err := m.Audio.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AudioAttachment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AudioAttachment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AudioAttachment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContentSha256) > 0 {
+		i -= len(m.ContentSha256)
+		copy(dAtA[i:], m.ContentSha256)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.ContentSha256)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Frames) > 0 {
+		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Frames[iNdEx])
+			copy(dAtA[i:], m.Frames[iNdEx])
+			i = encodeVarintPartyline(dAtA, i, uint64(len(m.Frames[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.FrameSizeMs != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.FrameSizeMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Codec) > 0 {
+		i -= len(m.Codec)
+		copy(dAtA[i:], m.Codec)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Codec)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SignerPublicKey) > 0 {
+		i -= len(m.SignerPublicKey)
+		copy(dAtA[i:], m.SignerPublicKey)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.SignerPublicKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Attachments) > 0 {
+		for iNdEx := len(m.Attachments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attachments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPartyline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TextContent) > 0 {
+		i -= len(m.TextContent)
+		copy(dAtA[i:], m.TextContent)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.TextContent)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SentAtTimeUnix != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.SentAtTimeUnix))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Author != nil {
+		{
+			size, err := m.Author.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedMessageContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedMessageContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedMessageContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attachments) > 0 {
+		for iNdEx := len(m.Attachments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attachments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPartyline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TextContent) > 0 {
+		i -= len(m.TextContent)
+		copy(dAtA[i:], m.TextContent)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.TextContent)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SentAtTimeUnix != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.SentAtTimeUnix))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Author != nil {
+		{
+			size, err := m.Author.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedAttachment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedAttachment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedAttachment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContentSha256) > 0 {
+		i -= len(m.ContentSha256)
+		copy(dAtA[i:], m.ContentSha256)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.ContentSha256)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Codec) > 0 {
+		i -= len(m.Codec)
+		copy(dAtA[i:], m.Codec)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Codec)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Receipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampUnix != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.TimestampUnix))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Type != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Recipient != nil {
+		{
+			size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutboxQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboxQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboxQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPartyline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutboxEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboxEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboxEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedAtUnix != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.QueuedAtUnix))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KnownMessageIds) > 0 {
+		for iNdEx := len(m.KnownMessageIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KnownMessageIds[iNdEx])
+			copy(dAtA[i:], m.KnownMessageIds[iNdEx])
+			i = encodeVarintPartyline(dAtA, i, uint64(len(m.KnownMessageIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SinceUnix != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.SinceUnix))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Envelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Envelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Envelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Payload != nil {
+		{
+			size := m.Payload.Size()
+			i -= size
+			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Envelope_Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Envelope_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Envelope_Goodbye) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Envelope_Goodbye) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Goodbye != nil {
+		{
+			size, err := m.Goodbye.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Envelope_Receipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Envelope_Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Receipt != nil {
+		{
+			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *InputDeviceInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InputDeviceInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InputDeviceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsSelected {
+		i--
+		if m.IsSelected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsDefault {
+		i--
+		if m.IsDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InputDeviceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InputDeviceList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InputDeviceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Devices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPartyline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SelectInputDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SelectInputDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SelectInputDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputDeviceInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputDeviceInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputDeviceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsSelected {
+		i--
+		if m.IsSelected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsDefault {
+		i--
+		if m.IsDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputDeviceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputDeviceList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputDeviceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Devices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPartyline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SelectOutputDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SelectOutputDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SelectOutputDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlaybackSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlaybackSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlaybackSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Muted {
+		i--
+		if m.Muted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MasterGain != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MasterGain))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetMasterGainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMasterGainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMasterGainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gain != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Gain))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetMutedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMutedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMutedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Muted {
+		i--
+		if m.Muted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetPeerGainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPeerGainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPeerGainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gain != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Gain))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeginAudioRecordingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeginAudioRecordingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeginAudioRecordingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxDuration) > 0 {
+		i -= len(m.MaxDuration)
+		copy(dAtA[i:], m.MaxDuration)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.MaxDuration)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeginTalkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeginTalkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeginTalkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SaveRecording {
+		i--
+		if m.SaveRecording {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MaxDuration) > 0 {
+		i -= len(m.MaxDuration)
+		copy(dAtA[i:], m.MaxDuration)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.MaxDuration)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopAudioRecordingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopAudioRecordingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopAudioRecordingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordingId) > 0 {
+		i -= len(m.RecordingId)
+		copy(dAtA[i:], m.RecordingId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlayAudioRecordingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlayAudioRecordingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayAudioRecordingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Interrupt {
+		i--
+		if m.Interrupt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Gain != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Gain))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.RecordingId) > 0 {
+		i -= len(m.RecordingId)
+		copy(dAtA[i:], m.RecordingId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlaybackControlRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlaybackControlRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlaybackControlRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordingId) > 0 {
+		i -= len(m.RecordingId)
+		copy(dAtA[i:], m.RecordingId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeekPlaybackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeekPlaybackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeekPlaybackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FrameIndex != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.FrameIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecordingId) > 0 {
+		i -= len(m.RecordingId)
+		copy(dAtA[i:], m.RecordingId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectToPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectToPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectToPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeerLocator) > 0 {
+		i -= len(m.PeerLocator)
+		copy(dAtA[i:], m.PeerLocator)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.PeerLocator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinRoomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinRoomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinRoomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoomName) > 0 {
+		i -= len(m.RoomName)
+		copy(dAtA[i:], m.RoomName)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RoomName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoredMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoredMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoredMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StoredAtUnix != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.StoredAtUnix))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sent {
+		i--
+		if m.Sent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BeforeAuthorPeerId) > 0 {
+		i -= len(m.BeforeAuthorPeerId)
+		copy(dAtA[i:], m.BeforeAuthorPeerId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.BeforeAuthorPeerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BeforeMessageId) > 0 {
+		i -= len(m.BeforeMessageId)
+		copy(dAtA[i:], m.BeforeMessageId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.BeforeMessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageHistoryPage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageHistoryPage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageHistoryPage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPartyline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkMessageReadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkMessageReadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkMessageReadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Author != nil {
+		{
+			size, err := m.Author.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size := m.Resp.Size()
+			i -= size
+			if _, err := m.Resp.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApiResponse_Ok) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiResponse_Ok) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Ok != nil {
+		{
+			size, err := m.Ok.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ApiResponse_Error) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiResponse_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ApiResponse_BeginAudioRecording) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiResponse_BeginAudioRecording) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeginAudioRecording != nil {
+		{
+			size, err := m.BeginAudioRecording.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ApiResponse_MessageHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiResponse_MessageHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MessageHistory != nil {
+		{
+			size, err := m.MessageHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ApiResponse_ImportAudio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiResponse_ImportAudio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ImportAudio != nil {
+		{
+			size, err := m.ImportAudio.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ErrorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BeginAudioRecordingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeginAudioRecordingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeginAudioRecordingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordingId) > 0 {
+		i -= len(m.RecordingId)
+		copy(dAtA[i:], m.RecordingId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportAudioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportAudioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportAudioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DurationMs != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.DurationMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecordingId) > 0 {
+		i -= len(m.RecordingId)
+		copy(dAtA[i:], m.RecordingId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evt != nil {
+		{
+			size := m.Evt.Size()
+			i -= size
+			if _, err := m.Evt.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.TimestampUnix != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.TimestampUnix))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_UserJoined) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_UserJoined) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UserJoined != nil {
+		{
+			size, err := m.UserJoined.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_UserLeft) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_UserLeft) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UserLeft != nil {
+		{
+			size, err := m.UserLeft.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_MessageReceived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_MessageReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MessageReceived != nil {
+		{
+			size, err := m.MessageReceived.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_MessageSent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_MessageSent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MessageSent != nil {
+		{
+			size, err := m.MessageSent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_ConnectToPeerRequested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_ConnectToPeerRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConnectToPeerRequested != nil {
+		{
+			size, err := m.ConnectToPeerRequested.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_RelayAddressAcquired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_RelayAddressAcquired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayAddressAcquired != nil {
+		{
+			size, err := m.RelayAddressAcquired.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_NatTypeDetected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_NatTypeDetected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NatTypeDetected != nil {
+		{
+			size, err := m.NatTypeDetected.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_PeerDiscovered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_PeerDiscovered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PeerDiscovered != nil {
+		{
+			size, err := m.PeerDiscovered.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xe2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_JoinRoomRequested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_JoinRoomRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JoinRoomRequested != nil {
+		{
+			size, err := m.JoinRoomRequested.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xea
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_RoomMemberFound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_RoomMemberFound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RoomMemberFound != nil {
+		{
+			size, err := m.RoomMemberFound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xf2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_ConnectionStateChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_ConnectionStateChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConnectionStateChanged != nil {
+		{
+			size, err := m.ConnectionStateChanged.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xfa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_AuthenticationFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_AuthenticationFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AuthenticationFailed != nil {
+		{
+			size, err := m.AuthenticationFailed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_ReceiptReceived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_ReceiptReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReceiptReceived != nil {
+		{
+			size, err := m.ReceiptReceived.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_MessageReadRequested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_MessageReadRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MessageReadRequested != nil {
+		{
+			size, err := m.MessageReadRequested.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_OutboxStatusChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_OutboxStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OutboxStatusChanged != nil {
+		{
+			size, err := m.OutboxStatusChanged.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_RecordingStopped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_RecordingStopped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RecordingStopped != nil {
+		{
+			size, err := m.RecordingStopped.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_PlaybackStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_PlaybackStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PlaybackStarted != nil {
+		{
+			size, err := m.PlaybackStarted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_PlaybackProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_PlaybackProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PlaybackProgress != nil {
+		{
+			size, err := m.PlaybackProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_PlaybackFinished) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_PlaybackFinished) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PlaybackFinished != nil {
+		{
+			size, err := m.PlaybackFinished.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_LiveVoiceStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_LiveVoiceStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LiveVoiceStarted != nil {
+		{
+			size, err := m.LiveVoiceStarted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_LiveVoiceEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_LiveVoiceEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LiveVoiceEnded != nil {
+		{
+			size, err := m.LiveVoiceEnded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+func (m *UserJoinedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserJoinedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserJoinedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserLeftEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserLeftEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLeftEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageReceivedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageReceivedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageReceivedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageSentEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageSentEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageSentEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectToPeerRequestedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectToPeerRequestedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectToPeerRequestedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayAddressAcquiredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayAddressAcquiredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayAddressAcquiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelayAddr) > 0 {
+		i -= len(m.RelayAddr)
+		copy(dAtA[i:], m.RelayAddr)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RelayAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NATTypeDetectedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NATTypeDetectedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NATTypeDetectedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HolePunchingSupported {
+		i--
+		if m.HolePunchingSupported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NatDeviceType) > 0 {
+		i -= len(m.NatDeviceType)
+		copy(dAtA[i:], m.NatDeviceType)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.NatDeviceType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransportProtocol) > 0 {
+		i -= len(m.TransportProtocol)
+		copy(dAtA[i:], m.TransportProtocol)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.TransportProtocol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerDiscoveredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerDiscoveredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerDiscoveredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for iNdEx := len(m.Addrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addrs[iNdEx])
+			copy(dAtA[i:], m.Addrs[iNdEx])
+			i = encodeVarintPartyline(dAtA, i, uint64(len(m.Addrs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinRoomRequestedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinRoomRequestedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinRoomRequestedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoomMemberFoundEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoomMemberFoundEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoomMemberFoundEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoomName) > 0 {
+		i -= len(m.RoomName)
+		copy(dAtA[i:], m.RoomName)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RoomName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionStateChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionStateChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionStateChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticationFailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticationFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticationFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ClaimedUser != nil {
+		{
+			size, err := m.ClaimedUser.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderPeerId) > 0 {
+		i -= len(m.SenderPeerId)
+		copy(dAtA[i:], m.SenderPeerId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.SenderPeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiptReceivedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptReceivedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptReceivedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		{
+			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageReadRequestedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageReadRequestedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageReadRequestedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutboxStatusChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboxStatusChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboxStatusChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Recipient != nil {
+		{
+			size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordingStoppedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordingStoppedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordingStoppedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordingId) > 0 {
+		i -= len(m.RecordingId)
+		copy(dAtA[i:], m.RecordingId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlaybackStartedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlaybackStartedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlaybackStartedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DurationMs != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.DurationMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalFrames != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.TotalFrames))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecordingId) > 0 {
+		i -= len(m.RecordingId)
+		copy(dAtA[i:], m.RecordingId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlaybackProgressEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlaybackProgressEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlaybackProgressEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalFrames != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.TotalFrames))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FrameIndex != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.FrameIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecordingId) > 0 {
+		i -= len(m.RecordingId)
+		copy(dAtA[i:], m.RecordingId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlaybackFinishedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlaybackFinishedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlaybackFinishedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stopped {
+		i--
+		if m.Stopped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecordingId) > 0 {
+		i -= len(m.RecordingId)
+		copy(dAtA[i:], m.RecordingId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.RecordingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoiceFrame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoiceFrame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoiceFrame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampMs != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.End {
+		i--
+		if m.End {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OpusFrame) > 0 {
+		i -= len(m.OpusFrame)
+		copy(dAtA[i:], m.OpusFrame)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.OpusFrame)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Seq != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TalkId) > 0 {
+		i -= len(m.TalkId)
+		copy(dAtA[i:], m.TalkId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.TalkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiveStreamStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiveStreamStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiveStreamStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BufferedFrames != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.BufferedFrames))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.JitterMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.JitterMs))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.Concealed != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.Concealed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Recovered != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.Recovered))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Lost != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.Lost))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Late != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.Late))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Received != 0 {
+		i = encodeVarintPartyline(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TalkId) > 0 {
+		i -= len(m.TalkId)
+		copy(dAtA[i:], m.TalkId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.TalkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiveStreamStatsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiveStreamStatsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiveStreamStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPartyline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiveVoiceStartedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiveVoiceStartedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiveVoiceStartedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Talker != nil {
+		{
+			size, err := m.Talker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TalkId) > 0 {
+		i -= len(m.TalkId)
+		copy(dAtA[i:], m.TalkId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.TalkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiveVoiceEndedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiveVoiceEndedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiveVoiceEndedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Talker != nil {
+		{
+			size, err := m.Talker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartyline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TalkId) > 0 {
+		i -= len(m.TalkId)
+		copy(dAtA[i:], m.TalkId)
+		i = encodeVarintPartyline(dAtA, i, uint64(len(m.TalkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPartyline(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPartyline(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.Nickname)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *Hello) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *Goodbye) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *Attachment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Kind != nil {
+		n += m.Kind.Size()
+	}
+	return n
+}
+
+func (m *Attachment_Audio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Audio != nil {
+		l = m.Audio.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *AudioAttachment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Codec)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.FrameSizeMs != 0 {
+		n += 1 + sovPartyline(uint64(m.FrameSizeMs))
+	}
+	if len(m.Frames) > 0 {
+		for _, b := range m.Frames {
+			l = len(b)
+			n += 1 + l + sovPartyline(uint64(l))
+		}
+	}
+	l = len(m.ContentSha256)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Author != nil {
+		l = m.Author.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.SentAtTimeUnix != 0 {
+		n += 1 + sovPartyline(uint64(m.SentAtTimeUnix))
+	}
+	l = len(m.TextContent)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if len(m.Attachments) > 0 {
+		for _, e := range m.Attachments {
+			l = e.Size()
+			n += 1 + l + sovPartyline(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.SignerPublicKey)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *SignedMessageContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Author != nil {
+		l = m.Author.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.SentAtTimeUnix != 0 {
+		n += 1 + sovPartyline(uint64(m.SentAtTimeUnix))
+	}
+	l = len(m.TextContent)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if len(m.Attachments) > 0 {
+		for _, e := range m.Attachments {
+			l = e.Size()
+			n += 1 + l + sovPartyline(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignedAttachment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.Codec)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.ContentSha256)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *Receipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Recipient != nil {
+		l = m.Recipient.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovPartyline(uint64(m.Type))
+	}
+	if m.TimestampUnix != 0 {
+		n += 1 + sovPartyline(uint64(m.TimestampUnix))
+	}
+	return n
+}
+
+func (m *OutboxQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovPartyline(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutboxEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.QueuedAtUnix != 0 {
+		n += 1 + sovPartyline(uint64(m.QueuedAtUnix))
+	}
+	return n
+}
+
+func (m *HistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SinceUnix != 0 {
+		n += 1 + sovPartyline(uint64(m.SinceUnix))
+	}
+	if len(m.KnownMessageIds) > 0 {
+		for _, s := range m.KnownMessageIds {
+			l = len(s)
+			n += 1 + l + sovPartyline(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Envelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		n += m.Payload.Size()
+	}
+	return n
+}
+
+func (m *Envelope_Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Envelope_Goodbye) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Goodbye != nil {
+		l = m.Goodbye.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Envelope_Receipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *InputDeviceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.IsDefault {
+		n += 2
+	}
+	if m.IsSelected {
+		n += 2
+	}
+	return n
+}
+
+func (m *InputDeviceList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovPartyline(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SelectInputDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *OutputDeviceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.IsDefault {
+		n += 2
+	}
+	if m.IsSelected {
+		n += 2
+	}
+	return n
+}
+
+func (m *OutputDeviceList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovPartyline(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SelectOutputDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *PlaybackSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MasterGain != 0 {
+		n += 9
+	}
+	if m.Muted {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetMasterGainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gain != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *SetMutedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Muted {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetPeerGainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Gain != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *BeginAudioRecordingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MaxDuration)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *BeginTalkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MaxDuration)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.SaveRecording {
+		n += 2
+	}
+	return n
+}
+
+func (m *StopAudioRecordingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordingId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *PlayAudioRecordingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordingId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Gain != 0 {
+		n += 9
+	}
+	if m.Interrupt {
+		n += 2
+	}
+	return n
+}
+
+func (m *PlaybackControlRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordingId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *SeekPlaybackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordingId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.FrameIndex != 0 {
+		n += 1 + sovPartyline(uint64(m.FrameIndex))
+	}
+	return n
+}
+
+func (m *ConnectToPeerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerLocator)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *JoinRoomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RoomName)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *StoredMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	if m.Sent {
+		n += 2
+	}
+	if m.StoredAtUnix != 0 {
+		n += 1 + sovPartyline(uint64(m.StoredAtUnix))
+	}
+	return n
+}
+
+func (m *MessageHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BeforeMessageId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPartyline(uint64(m.Limit))
+	}
+	l = len(m.BeforeAuthorPeerId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageHistoryPage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovPartyline(uint64(l))
+		}
+	}
+	if m.HasMore {
+		n += 2
+	}
+	return n
+}
+
+func (m *MarkMessageReadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Author != nil {
+		l = m.Author.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *ApiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		n += m.Resp.Size()
+	}
+	return n
+}
+
+func (m *ApiResponse_Ok) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok != nil {
+		l = m.Ok.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *ApiResponse_Error) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *ApiResponse_BeginAudioRecording) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginAudioRecording != nil {
+		l = m.BeginAudioRecording.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *ApiResponse_MessageHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MessageHistory != nil {
+		l = m.MessageHistory.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *ApiResponse_ImportAudio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ImportAudio != nil {
+		l = m.ImportAudio.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *ErrorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *OkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BeginAudioRecordingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordingId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportAudioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordingId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.DurationMs != 0 {
+		n += 1 + sovPartyline(uint64(m.DurationMs))
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimestampUnix != 0 {
+		n += 1 + sovPartyline(uint64(m.TimestampUnix))
+	}
+	if m.Evt != nil {
+		n += m.Evt.Size()
+	}
+	return n
+}
+
+func (m *Event_UserJoined) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserJoined != nil {
+		l = m.UserJoined.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_UserLeft) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserLeft != nil {
+		l = m.UserLeft.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_MessageReceived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MessageReceived != nil {
+		l = m.MessageReceived.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_MessageSent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MessageSent != nil {
+		l = m.MessageSent.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_ConnectToPeerRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConnectToPeerRequested != nil {
+		l = m.ConnectToPeerRequested.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_RelayAddressAcquired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayAddressAcquired != nil {
+		l = m.RelayAddressAcquired.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_NatTypeDetected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NatTypeDetected != nil {
+		l = m.NatTypeDetected.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_PeerDiscovered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeerDiscovered != nil {
+		l = m.PeerDiscovered.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_JoinRoomRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JoinRoomRequested != nil {
+		l = m.JoinRoomRequested.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_RoomMemberFound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RoomMemberFound != nil {
+		l = m.RoomMemberFound.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_ConnectionStateChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConnectionStateChanged != nil {
+		l = m.ConnectionStateChanged.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_AuthenticationFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthenticationFailed != nil {
+		l = m.AuthenticationFailed.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_ReceiptReceived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReceiptReceived != nil {
+		l = m.ReceiptReceived.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_MessageReadRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MessageReadRequested != nil {
+		l = m.MessageReadRequested.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_OutboxStatusChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutboxStatusChanged != nil {
+		l = m.OutboxStatusChanged.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_RecordingStopped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordingStopped != nil {
+		l = m.RecordingStopped.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_PlaybackStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlaybackStarted != nil {
+		l = m.PlaybackStarted.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_PlaybackProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlaybackProgress != nil {
+		l = m.PlaybackProgress.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_PlaybackFinished) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlaybackFinished != nil {
+		l = m.PlaybackFinished.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_LiveVoiceStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiveVoiceStarted != nil {
+		l = m.LiveVoiceStarted.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *Event_LiveVoiceEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiveVoiceEnded != nil {
+		l = m.LiveVoiceEnded.Size()
+		n += 2 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+func (m *UserJoinedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *UserLeftEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageReceivedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageSentEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *ConnectToPeerRequestedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayAddressAcquiredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RelayAddr)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *NATTypeDetectedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TransportProtocol)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.NatDeviceType)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.HolePunchingSupported {
+		n += 2
+	}
+	return n
+}
+
+func (m *PeerDiscoveredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovPartyline(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JoinRoomRequestedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *RoomMemberFoundEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RoomName)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *ConnectionStateChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPartyline(uint64(m.State))
+	}
+	return n
+}
+
+func (m *AuthenticationFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderPeerId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.ClaimedUser != nil {
+		l = m.ClaimedUser.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *ReceiptReceivedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageReadRequestedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.Request != nil {
 		l = m.Request.Size()
 		n += 1 + l + sovPartyline(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *OutboxStatusChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Recipient != nil {
+		l = m.Recipient.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPartyline(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *RecordingStoppedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordingId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *PlaybackStartedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordingId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.TotalFrames != 0 {
+		n += 1 + sovPartyline(uint64(m.TotalFrames))
+	}
+	if m.DurationMs != 0 {
+		n += 1 + sovPartyline(uint64(m.DurationMs))
+	}
+	return n
+}
+
+func (m *PlaybackProgressEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordingId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.FrameIndex != 0 {
+		n += 1 + sovPartyline(uint64(m.FrameIndex))
+	}
+	if m.TotalFrames != 0 {
+		n += 1 + sovPartyline(uint64(m.TotalFrames))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *PlaybackFinishedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordingId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Stopped {
+		n += 2
+	}
+	return n
+}
+
+func (m *VoiceFrame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TalkId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPartyline(uint64(m.Seq))
+	}
+	l = len(m.OpusFrame)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.End {
+		n += 2
+	}
+	if m.TimestampMs != 0 {
+		n += 1 + sovPartyline(uint64(m.TimestampMs))
+	}
+	return n
+}
+
+func (m *LiveStreamStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	l = len(m.TalkId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Received != 0 {
+		n += 1 + sovPartyline(uint64(m.Received))
+	}
+	if m.Late != 0 {
+		n += 1 + sovPartyline(uint64(m.Late))
+	}
+	if m.Lost != 0 {
+		n += 1 + sovPartyline(uint64(m.Lost))
+	}
+	if m.Recovered != 0 {
+		n += 1 + sovPartyline(uint64(m.Recovered))
+	}
+	if m.Concealed != 0 {
+		n += 1 + sovPartyline(uint64(m.Concealed))
+	}
+	if m.JitterMs != 0 {
+		n += 9
+	}
+	if m.BufferedFrames != 0 {
+		n += 1 + sovPartyline(uint64(m.BufferedFrames))
+	}
+	return n
+}
+
+func (m *LiveStreamStatsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovPartyline(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiveVoiceStartedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TalkId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Talker != nil {
+		l = m.Talker.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func (m *LiveVoiceEndedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TalkId)
+	if l > 0 {
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	if m.Talker != nil {
+		l = m.Talker.Size()
+		n += 1 + l + sovPartyline(uint64(l))
+	}
+	return n
+}
+
+func sovPartyline(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPartyline(x uint64) (n int) {
+	return sovPartyline(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nickname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hello) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hello: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hello: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &UserInfo{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Goodbye) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Goodbye: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Goodbye: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &UserInfo{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attachment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attachment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attachment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AudioAttachment{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Kind = &Attachment_Audio{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AudioAttachment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AudioAttachment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AudioAttachment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameSizeMs", wireType)
+			}
+			m.FrameSizeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrameSizeMs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frames = append(m.Frames, make([]byte, postIndex-iNdEx))
+			copy(m.Frames[len(m.Frames)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentSha256", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentSha256 = append(m.ContentSha256[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentSha256 == nil {
+				m.ContentSha256 = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Author == nil {
+				m.Author = &UserInfo{}
+			}
+			if err := m.Author.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAtTimeUnix", wireType)
+			}
+			m.SentAtTimeUnix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentAtTimeUnix |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TextContent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TextContent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attachments = append(m.Attachments, &Attachment{})
+			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerPublicKey = append(m.SignerPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignerPublicKey == nil {
+				m.SignerPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedMessageContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedMessageContent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedMessageContent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Author == nil {
+				m.Author = &UserInfo{}
+			}
+			if err := m.Author.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAtTimeUnix", wireType)
+			}
+			m.SentAtTimeUnix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentAtTimeUnix |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TextContent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TextContent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attachments = append(m.Attachments, &SignedAttachment{})
+			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedAttachment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedAttachment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedAttachment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentSha256", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentSha256 = append(m.ContentSha256[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentSha256 == nil {
+				m.ContentSha256 = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Receipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recipient == nil {
+				m.Recipient = &UserInfo{}
+			}
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ReceiptType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampUnix", wireType)
+			}
+			m.TimestampUnix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampUnix |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutboxQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboxQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboxQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &UserInfo{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &OutboxEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutboxEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboxEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboxEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAtUnix", wireType)
+			}
+			m.QueuedAtUnix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedAtUnix |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceUnix", wireType)
+			}
+			m.SinceUnix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceUnix |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownMessageIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KnownMessageIds = append(m.KnownMessageIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Envelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Envelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Envelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Message{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Envelope_Message{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Goodbye", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Goodbye{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Envelope_Goodbye{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Receipt{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Envelope_Receipt{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InputDeviceInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputDeviceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputDeviceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDefault = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSelected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSelected = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InputDeviceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputDeviceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputDeviceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, &InputDeviceInfo{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SelectInputDeviceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SelectInputDeviceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SelectInputDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputDeviceInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputDeviceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputDeviceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDefault = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSelected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSelected = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputDeviceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputDeviceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputDeviceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, &OutputDeviceInfo{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SelectOutputDeviceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SelectOutputDeviceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SelectOutputDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlaybackSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlaybackSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlaybackSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterGain", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MasterGain = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Muted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Muted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetMasterGainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetMasterGainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMasterGainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gain", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Gain = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetMutedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetMutedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMutedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Muted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Muted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetPeerGainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPeerGainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPeerGainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gain", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Gain = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginAudioRecordingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginAudioRecordingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginAudioRecordingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginTalkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginTalkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginTalkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaveRecording", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SaveRecording = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopAudioRecordingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopAudioRecordingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopAudioRecordingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlayAudioRecordingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlayAudioRecordingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlayAudioRecordingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gain", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Gain = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interrupt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Interrupt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlaybackControlRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlaybackControlRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlaybackControlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeekPlaybackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeekPlaybackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeekPlaybackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameIndex", wireType)
+			}
+			m.FrameIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrameIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectToPeerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectToPeerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectToPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerLocator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerLocator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinRoomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinRoomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinRoomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoomName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoredMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoredMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoredMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sent = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredAtUnix", wireType)
+			}
+			m.StoredAtUnix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredAtUnix |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeMessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeMessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeAuthorPeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeAuthorPeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageHistoryPage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageHistoryPage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageHistoryPage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &StoredMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkMessageReadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkMessageReadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkMessageReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Author == nil {
+				m.Author = &UserInfo{}
+			}
+			if err := m.Author.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OkResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resp = &ApiResponse_Ok{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ErrorResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resp = &ApiResponse_Error{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginAudioRecording", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BeginAudioRecordingResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resp = &ApiResponse_BeginAudioRecording{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MessageHistoryPage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resp = &ApiResponse_MessageHistory{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportAudio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ImportAudioResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Resp = &ApiResponse_ImportAudio{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginAudioRecordingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginAudioRecordingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginAudioRecordingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportAudioResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportAudioResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportAudioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMs", wireType)
+			}
+			m.DurationMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampUnix", wireType)
+			}
+			m.TimestampUnix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampUnix |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserJoined", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UserJoinedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_UserJoined{v}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserLeft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UserLeftEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_UserLeft{v}
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageReceived", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MessageReceivedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_MessageReceived{v}
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageSent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MessageSentEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_MessageSent{v}
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectToPeerRequested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConnectToPeerRequestedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_ConnectToPeerRequested{v}
+			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayAddressAcquired", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RelayAddressAcquiredEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_RelayAddressAcquired{v}
+			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NatTypeDetected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NATTypeDetectedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_NatTypeDetected{v}
+			iNdEx = postIndex
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerDiscovered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PeerDiscoveredEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_PeerDiscovered{v}
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRoomRequested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JoinRoomRequestedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_JoinRoomRequested{v}
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomMemberFound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RoomMemberFoundEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_RoomMemberFound{v}
+			iNdEx = postIndex
+		case 111:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionStateChanged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConnectionStateChangedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_ConnectionStateChanged{v}
+			iNdEx = postIndex
+		case 112:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationFailed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AuthenticationFailedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_AuthenticationFailed{v}
+			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptReceived", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReceiptReceivedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_ReceiptReceived{v}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageReadRequested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MessageReadRequestedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_MessageReadRequested{v}
+			iNdEx = postIndex
+		case 115:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboxStatusChanged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OutboxStatusChangedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_OutboxStatusChanged{v}
+			iNdEx = postIndex
+		case 116:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordingStopped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RecordingStoppedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_RecordingStopped{v}
+			iNdEx = postIndex
+		case 117:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaybackStarted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PlaybackStartedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_PlaybackStarted{v}
+			iNdEx = postIndex
+		case 118:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaybackProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PlaybackProgressEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_PlaybackProgress{v}
+			iNdEx = postIndex
+		case 119:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaybackFinished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PlaybackFinishedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_PlaybackFinished{v}
+			iNdEx = postIndex
+		case 120:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveVoiceStarted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LiveVoiceStartedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_LiveVoiceStarted{v}
+			iNdEx = postIndex
+		case 121:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveVoiceEnded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LiveVoiceEndedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Evt = &Event_LiveVoiceEnded{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserJoinedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserJoinedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserJoinedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &UserInfo{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserLeftEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserLeftEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserLeftEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &UserInfo{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageReceivedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageReceivedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageReceivedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageSentEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageSentEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageSentEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectToPeerRequestedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectToPeerRequestedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectToPeerRequestedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ConnectToPeerRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayAddressAcquiredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPartyline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayAddressAcquiredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayAddressAcquiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPartyline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovPartyline(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPartyline(x uint64) (n int) {
-	return sovPartyline(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *UserInfo) Unmarshal(dAtA []byte) error {
+func (m *NATTypeDetectedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2770,15 +15164,15 @@ func (m *UserInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: NATTypeDetectedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NATTypeDetectedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransportProtocol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2806,11 +15200,11 @@ func (m *UserInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerId = string(dAtA[iNdEx:postIndex])
+			m.TransportProtocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NatDeviceType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2838,8 +15232,28 @@ func (m *UserInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nickname = string(dAtA[iNdEx:postIndex])
+			m.NatDeviceType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolePunchingSupported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HolePunchingSupported = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPartyline(dAtA[iNdEx:])
@@ -2861,7 +15275,7 @@ func (m *UserInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Hello) Unmarshal(dAtA []byte) error {
+func (m *PeerDiscoveredEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2884,10 +15298,10 @@ func (m *Hello) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Hello: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeerDiscoveredEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Hello: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeerDiscoveredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2926,61 +15340,11 @@ func (m *Hello) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPartyline(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Goodbye) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPartyline
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Goodbye: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Goodbye: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -2990,27 +15354,23 @@ func (m *Goodbye) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPartyline
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPartyline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &UserInfo{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3033,7 +15393,7 @@ func (m *Goodbye) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attachment) Unmarshal(dAtA []byte) error {
+func (m *JoinRoomRequestedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3056,47 +15416,15 @@ func (m *Attachment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attachment: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinRoomRequestedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attachment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinRoomRequestedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPartyline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 101:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Audio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3123,11 +15451,12 @@ func (m *Attachment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AudioAttachment{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &JoinRoomRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Kind = &Attachment_Audio{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3150,7 +15479,7 @@ func (m *Attachment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AudioAttachment) Unmarshal(dAtA []byte) error {
+func (m *RoomMemberFoundEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3173,15 +15502,15 @@ func (m *AudioAttachment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AudioAttachment: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoomMemberFoundEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AudioAttachment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoomMemberFoundEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3209,32 +15538,13 @@ func (m *AudioAttachment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Codec = string(dAtA[iNdEx:postIndex])
+			m.RoomName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrameSizeMs", wireType)
-			}
-			m.FrameSizeMs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPartyline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FrameSizeMs |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -3244,23 +15554,27 @@ func (m *AudioAttachment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPartyline
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPartyline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Frames = append(m.Frames, make([]byte, postIndex-iNdEx))
-			copy(m.Frames[len(m.Frames)-1], dAtA[iNdEx:postIndex])
+			if m.User == nil {
+				m.User = &UserInfo{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3283,7 +15597,7 @@ func (m *AudioAttachment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Message) Unmarshal(dAtA []byte) error {
+func (m *ConnectionStateChangedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3306,72 +15620,17 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionStateChangedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionStateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPartyline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Author == nil {
-				m.Author = &UserInfo{}
-			}
-			if err := m.Author.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentAtTimeUnix", wireType)
-			}
-			m.SentAtTimeUnix = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPartyline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SentAtTimeUnix |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TextContent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -3381,29 +15640,33 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPartyline
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPartyline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TextContent = string(dAtA[iNdEx:postIndex])
+			if m.User == nil {
+				m.User = &UserInfo{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -3413,26 +15676,11 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= ConnectionState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attachments = append(m.Attachments, &Attachment{})
-			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPartyline(dAtA[iNdEx:])
@@ -3454,7 +15702,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InputDeviceInfo) Unmarshal(dAtA []byte) error {
+func (m *AuthenticationFailedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3477,15 +15725,15 @@ func (m *InputDeviceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InputDeviceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticationFailedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputDeviceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticationFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderPeerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3513,13 +15761,13 @@ func (m *InputDeviceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			m.SenderPeerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedUser", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -3529,29 +15777,33 @@ func (m *InputDeviceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPartyline
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPartyline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.ClaimedUser == nil {
+				m.ClaimedUser = &UserInfo{}
+			}
+			if err := m.ClaimedUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDefault", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -3561,12 +15813,24 @@ func (m *InputDeviceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsDefault = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPartyline(dAtA[iNdEx:])
@@ -3588,7 +15852,7 @@ func (m *InputDeviceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InputDeviceList) Unmarshal(dAtA []byte) error {
+func (m *ReceiptReceivedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3611,15 +15875,15 @@ func (m *InputDeviceList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InputDeviceList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiptReceivedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputDeviceList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiptReceivedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3646,8 +15910,10 @@ func (m *InputDeviceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Devices = append(m.Devices, &InputDeviceInfo{})
-			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Receipt == nil {
+				m.Receipt = &Receipt{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3672,7 +15938,7 @@ func (m *InputDeviceList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BeginAudioRecordingRequest) Unmarshal(dAtA []byte) error {
+func (m *MessageReadRequestedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3695,17 +15961,17 @@ func (m *BeginAudioRecordingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeginAudioRecordingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageReadRequestedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeginAudioRecordingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageReadRequestedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -3715,23 +15981,27 @@ func (m *BeginAudioRecordingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPartyline
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPartyline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			if m.Request == nil {
+				m.Request = &MarkMessageReadRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3754,7 +16024,7 @@ func (m *BeginAudioRecordingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopAudioRecordingRequest) Unmarshal(dAtA []byte) error {
+func (m *OutboxStatusChangedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3777,15 +16047,15 @@ func (m *StopAudioRecordingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopAudioRecordingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutboxStatusChangedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopAudioRecordingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutboxStatusChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3813,8 +16083,63 @@ func (m *StopAudioRecordingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordingId = string(dAtA[iNdEx:postIndex])
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recipient == nil {
+				m.Recipient = &UserInfo{}
+			}
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OutboxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPartyline(dAtA[iNdEx:])
@@ -3836,7 +16161,7 @@ func (m *StopAudioRecordingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PlayAudioRecordingRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordingStoppedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3859,10 +16184,10 @@ func (m *PlayAudioRecordingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PlayAudioRecordingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordingStoppedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlayAudioRecordingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordingStoppedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3918,7 +16243,7 @@ func (m *PlayAudioRecordingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectToPeerRequest) Unmarshal(dAtA []byte) error {
+func (m *PlaybackStartedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3941,15 +16266,15 @@ func (m *ConnectToPeerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectToPeerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlaybackStartedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectToPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlaybackStartedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerLocator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3977,8 +16302,46 @@ func (m *ConnectToPeerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerLocator = string(dAtA[iNdEx:postIndex])
+			m.RecordingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFrames", wireType)
+			}
+			m.TotalFrames = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalFrames |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMs", wireType)
+			}
+			m.DurationMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPartyline(dAtA[iNdEx:])
@@ -4000,7 +16363,7 @@ func (m *ConnectToPeerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApiResponse) Unmarshal(dAtA []byte) error {
+func (m *PlaybackProgressEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4023,17 +16386,17 @@ func (m *ApiResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApiResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlaybackProgressEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlaybackProgressEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4043,32 +16406,29 @@ func (m *ApiResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPartyline
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPartyline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &OkResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Resp = &ApiResponse_Ok{v}
+			m.RecordingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameIndex", wireType)
 			}
-			var msglen int
+			m.FrameIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4078,32 +16438,35 @@ func (m *ApiResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FrameIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ErrorResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Resp = &ApiResponse_Error{v}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginAudioRecording", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFrames", wireType)
+			}
+			m.TotalFrames = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalFrames |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4113,27 +16476,12 @@ func (m *ApiResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &BeginAudioRecordingResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Resp = &ApiResponse_BeginAudioRecording{v}
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPartyline(dAtA[iNdEx:])
@@ -4155,7 +16503,7 @@ func (m *ApiResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ErrorResponse) Unmarshal(dAtA []byte) error {
+func (m *PlaybackFinishedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4178,15 +16526,15 @@ func (m *ErrorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ErrorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlaybackFinishedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlaybackFinishedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4214,58 +16562,28 @@ func (m *ErrorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			m.RecordingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPartyline(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OkResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPartyline
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OkResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Stopped = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPartyline(dAtA[iNdEx:])
@@ -4287,7 +16605,7 @@ func (m *OkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BeginAudioRecordingResponse) Unmarshal(dAtA []byte) error {
+func (m *VoiceFrame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4310,15 +16628,15 @@ func (m *BeginAudioRecordingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeginAudioRecordingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoiceFrame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeginAudioRecordingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoiceFrame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TalkId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4346,8 +16664,100 @@ func (m *BeginAudioRecordingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordingId = string(dAtA[iNdEx:postIndex])
+			m.TalkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpusFrame", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpusFrame = append(m.OpusFrame[:0], dAtA[iNdEx:postIndex]...)
+			if m.OpusFrame == nil {
+				m.OpusFrame = []byte{}
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.End = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPartyline(dAtA[iNdEx:])
@@ -4369,7 +16779,7 @@ func (m *BeginAudioRecordingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *LiveStreamStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4392,17 +16802,17 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiveStreamStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiveStreamStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampUnix", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			m.TimestampUnix = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4412,16 +16822,29 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimestampUnix |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 101:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartyline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserJoined", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TalkId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4431,32 +16854,68 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPartyline
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPartyline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UserJoinedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Evt = &Event_UserJoined{v}
+			m.TalkId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserLeft", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Late", wireType)
+			}
+			m.Late = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4466,32 +16925,16 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Late |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &UserLeftEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Evt = &Event_UserLeft{v}
-			iNdEx = postIndex
-		case 103:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageReceived", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lost", wireType)
 			}
-			var msglen int
+			m.Lost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4501,32 +16944,35 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Lost |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovered", wireType)
 			}
-			v := &MessageReceivedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Recovered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartyline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recovered |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Evt = &Event_MessageReceived{v}
-			iNdEx = postIndex
-		case 104:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageSent", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concealed", wireType)
 			}
-			var msglen int
+			m.Concealed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4536,32 +16982,27 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Concealed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPartyline
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JitterMs", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MessageSentEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Evt = &Event_MessageSent{v}
-			iNdEx = postIndex
-		case 105:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectToPeerRequested", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.JitterMs = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedFrames", wireType)
 			}
-			var msglen int
+			m.BufferedFrames = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4571,27 +17012,11 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BufferedFrames |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ConnectToPeerRequestedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Evt = &Event_ConnectToPeerRequested{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPartyline(dAtA[iNdEx:])
@@ -4613,7 +17038,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserJoinedEvent) Unmarshal(dAtA []byte) error {
+func (m *LiveStreamStatsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4636,15 +17061,15 @@ func (m *UserJoinedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserJoinedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiveStreamStatsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserJoinedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiveStreamStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4671,10 +17096,8 @@ func (m *UserJoinedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &UserInfo{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Streams = append(m.Streams, &LiveStreamStats{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4699,7 +17122,7 @@ func (m *UserJoinedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserLeftEvent) Unmarshal(dAtA []byte) error {
+func (m *LiveVoiceStartedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4722,17 +17145,17 @@ func (m *UserLeftEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserLeftEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiveVoiceStartedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserLeftEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiveVoiceStartedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TalkId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4742,81 +17165,27 @@ func (m *UserLeftEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPartyline
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPartyline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &UserInfo{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TalkId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPartyline(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MessageReceivedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPartyline
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MessageReceivedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageReceivedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Talker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4843,10 +17212,10 @@ func (m *MessageReceivedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Message == nil {
-				m.Message = &Message{}
+			if m.Talker == nil {
+				m.Talker = &UserInfo{}
 			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Talker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4871,7 +17240,7 @@ func (m *MessageReceivedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageSentEvent) Unmarshal(dAtA []byte) error {
+func (m *LiveVoiceEndedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4894,17 +17263,17 @@ func (m *MessageSentEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageSentEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiveVoiceEndedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageSentEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiveVoiceEndedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TalkId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPartyline
@@ -4914,81 +17283,27 @@ func (m *MessageSentEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPartyline
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPartyline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Message == nil {
-				m.Message = &Message{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TalkId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPartyline(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPartyline
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConnectToPeerRequestedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPartyline
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectToPeerRequestedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectToPeerRequestedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Talker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5015,10 +17330,10 @@ func (m *ConnectToPeerRequestedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ConnectToPeerRequest{}
+			if m.Talker == nil {
+				m.Talker = &UserInfo{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Talker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
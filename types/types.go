@@ -3,3 +3,13 @@ package types
 const (
 	AttachmentTypeAudioOpus = "audio/opus"
 )
+
+// MessageKey identifies a message by its author's peer id and its message id. Message ids are chosen by the
+// author, so on their own they'd let anyone claim someone else's id first. It returns the empty string if the
+// message has no id.
+func MessageKey(msg *Message) string {
+	if msg.GetMessageId() == "" {
+		return ""
+	}
+	return msg.Author.GetPeerId() + "/" + msg.MessageId
+}